@@ -0,0 +1,118 @@
+package main
+
+// This file restricts the rubric values to the character set of the DSN, and
+// encodes the output in ISO-8859-1 for the systems which expect it, or decodes
+// DSN files encoded in it.
+
+import (
+	"io"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// characterReplacements lists the characters outside of the DSN character set
+// which have a close equivalent in it
+var characterReplacements = map[rune]string{
+	'‘': "'", '’': "'", '‚': "'", '`': "'", '´': "'",
+	'“': "\"", '”': "\"", '„': "\"", '«': "\"", '»': "\"",
+	'‐': "-", '‑': "-", '–': "-", '—': "-",
+	'…': "...",
+	'œ': "oe", 'Œ': "OE", 'æ': "ae", 'Æ': "AE", 'ß': "ss",
+	// Latin letters with diacritics missing from ISO-8859-1
+	'Ą': "A", 'ą': "a", 'Ć': "C", 'ć': "c", 'Č': "C", 'č': "c", 'Ę': "E", 'ę': "e",
+	'Ğ': "G", 'ğ': "g", 'İ': "I", 'ı': "i", 'Ł': "L", 'ł': "l", 'Ń': "N", 'ń': "n",
+	'Ő': "O", 'ő': "o", 'Ř': "R", 'ř': "r", 'Ś': "S", 'ś': "s", 'Ş': "S", 'ş': "s",
+	'Š': "S", 'š': "s", 'Ű': "U", 'ű': "u", 'Ź': "Z", 'ź': "z", 'Ż': "Z", 'ż': "z",
+	'Ž': "Z", 'ž': "z", 'Ÿ': "Y",
+	' ': " ", '\t': " ", '\n': " ", '\r': " ",
+}
+
+// isDSNRune reports whether r belongs to the DSN character set: the printable
+// ASCII characters and the letters of ISO-8859-1
+func isDSNRune(r rune) bool {
+	switch {
+	case r >= 0x20 && r <= 0x7e:
+		return true
+	case r == '×' || r == '÷':
+		return false
+	case r >= 0xc0 && r <= 0xff:
+		return true
+	}
+	return false
+}
+
+// sanitize restricts a rubric value to the DSN character set: replaced
+// characters are substituted, the others are removed, and spaces are trimmed
+// and collapsed.
+func sanitize(value string, upper bool) string {
+	var b strings.Builder
+	for _, r := range value {
+		if replacement, ok := characterReplacements[r]; ok {
+			b.WriteString(replacement)
+		} else if isDSNRune(r) {
+			b.WriteRune(r)
+		}
+	}
+
+	s := strings.Join(strings.Fields(b.String()), " ")
+	if upper {
+		// ÿ has no uppercase in ISO-8859-1
+		s = strings.Map(func(r rune) rune {
+			if r == 'ÿ' {
+				return 'Y'
+			}
+			return unicode.ToUpper(r)
+		}, s)
+	}
+	return s
+}
+
+// escape doubles the apostrophes of a value, as they delimit it in the DSN
+func escape(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
+
+// unescape reverts escape
+func unescape(value string) string {
+	return strings.ReplaceAll(value, "''", "'")
+}
+
+// decodeLatin1 decodes text encoded in ISO-8859-1, whose bytes are the code
+// points of its characters
+func decodeLatin1(p []byte) string {
+	runes := make([]rune, len(p))
+	for i, b := range p {
+		runes[i] = rune(b)
+	}
+	return string(runes)
+}
+
+// latin1Writer encodes the UTF-8 text written to it in ISO-8859-1. The
+// characters outside of ISO-8859-1 are written as a question mark.
+type latin1Writer struct {
+	w       io.Writer
+	pending []byte // Incomplete UTF-8 sequence at the end of the last write
+}
+
+func (l *latin1Writer) Write(p []byte) (int, error) {
+	data := append(l.pending, p...)
+	out := make([]byte, 0, len(data))
+	for len(data) > 0 {
+		if !utf8.FullRune(data) {
+			break
+		}
+		r, size := utf8.DecodeRune(data)
+		if r > 0xff {
+			r = '?'
+		}
+		out = append(out, byte(r))
+		data = data[size:]
+	}
+	l.pending = append([]byte(nil), data...)
+
+	if _, err := l.w.Write(out); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestSanitize(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		upper bool
+		want  string
+	}{
+		{name: "Latin-1 letters", value: "Hélène Çağan", want: "Hélène Çagan"},
+		{name: "typographic apostrophe", value: "L’Hôpital", want: "L'Hôpital"},
+		{name: "ligature", value: "Cœur", want: "Coeur"},
+		{name: "spaces trimmed and collapsed", value: " Jean \t\n Pierre ", want: "Jean Pierre"},
+		{name: "runes outside of ISO-8859-1 removed", value: "Anna 李 ☃", want: "Anna"},
+		{name: "emptied", value: "李娜", want: ""},
+		{name: "upper", value: "d’Hérouville", upper: true, want: "D'HÉROUVILLE"},
+		{name: "upper y with diaeresis", value: "Haÿ", upper: true, want: "HAY"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := sanitize(tt.value, tt.upper); got != tt.want {
+				t.Errorf("sanitize(%q, %t) = %q, want %q", tt.value, tt.upper, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscape(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"Dupont", "Dupont"},
+		{"L'Hôpital", "L''Hôpital"},
+		{"''", "''''"},
+	}
+
+	for _, tt := range tests {
+		got := escape(tt.value)
+		if got != tt.want {
+			t.Errorf("escape(%q) = %q, want %q", tt.value, got, tt.want)
+		}
+		if unescaped := unescape(got); unescaped != tt.value {
+			t.Errorf("unescape(%q) = %q, want %q", got, unescaped, tt.value)
+		}
+	}
+}
+
+func TestLatin1Writer(t *testing.T) {
+	tests := []struct {
+		name   string
+		writes []string
+		want   []byte
+	}{
+		{name: "ASCII", writes: []string{"S21,'A'"}, want: []byte("S21,'A'")},
+		{name: "Latin-1 letters", writes: []string{"Zoé"}, want: []byte{'Z', 'o', 0xe9}},
+		{name: "rune outside of ISO-8859-1", writes: []string{"a€b"}, want: []byte("a?b")},
+		// é is 0xc3 0xa9 in UTF-8
+		{name: "rune split across writes", writes: []string{"Zo\xc3", "\xa9"}, want: []byte{'Z', 'o', 0xe9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := &latin1Writer{w: &buf}
+			for _, s := range tt.writes {
+				if n, err := w.Write([]byte(s)); err != nil || n != len(s) {
+					t.Fatalf("Write(%q) = %d, %v", s, n, err)
+				}
+			}
+			if !bytes.Equal(buf.Bytes(), tt.want) {
+				t.Errorf("written %q, want %q", buf.Bytes(), tt.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,14 @@
 package main
 
-import "log"
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
 
 // BlocID represents the ID of a DSN bloc
 type BlocID string
@@ -14,10 +22,110 @@ type Bloc struct {
 	Label string
 }
 
-// Attribute represents a DSN attribute with its ID and label
+// Attribute represents a DSN attribute with its ID, label and the Schema of
+// its values
 type Attribute struct {
-	ID    AttributeID
-	Label string
+	ID     AttributeID
+	Label  string
+	Schema Schema
+}
+
+// DataType represents the type of the values of a DSN attribute
+type DataType string
+
+const (
+	TypeAlphanumeric DataType = "X"  // Alphanumérique
+	TypeNumeric      DataType = "N"  // Numérique
+	TypeDecimal      DataType = "D"  // Décimal, with a dot separator
+	TypeDate         DataType = "DT" // Date
+	TypeCode         DataType = "C"  // Code
+)
+
+// Schema represents the constraints on the values of a DSN attribute
+type Schema struct {
+	Type      DataType
+	MinLength int
+	MaxLength int
+	Pattern   *regexp.Regexp
+	Codes     []string // Allowed codes, empty when the list is not closed
+}
+
+var (
+	codePattern       = regexp.MustCompile(`^[0-9A-Za-z]+$`)
+	nirPattern        = regexp.MustCompile(`^[1-478][0-9]{4}(2[AB]|[0-9]{2})[0-9]{6}([0-9]{2})?$`)
+	countryPattern    = regexp.MustCompile(`^[A-Z]{2}$`)
+	departmentPattern = regexp.MustCompile(`^(2[AB]|[0-9]{2,3})$`)
+	inseePattern      = regexp.MustCompile(`^(2[AB]|[0-9]{2})[0-9]{3}$`)
+	apePattern        = regexp.MustCompile(`^[0-9]{4}[A-Z]$`)
+	emailPattern      = regexp.MustCompile(`^[^@ ]+@[^@ ]+\.[^@ ]+$`)
+	ibanPattern       = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[0-9A-Z]{11,30}$`)
+	bicPattern        = regexp.MustCompile(`^[A-Z]{6}[0-9A-Z]{2}([0-9A-Z]{3})?$`)
+	monthPattern      = regexp.MustCompile(`^[0-9]{4}-(0[1-9]|1[0-2])$`)
+)
+
+// textRubric is the Schema of a free text
+func textRubric(minLength, maxLength int) Schema {
+	return Schema{Type: TypeAlphanumeric, MinLength: minLength, MaxLength: maxLength}
+}
+
+// numericRubric is the Schema of a number made of digits only
+func numericRubric(minLength, maxLength int) Schema {
+	return Schema{Type: TypeNumeric, MinLength: minLength, MaxLength: maxLength}
+}
+
+// decimalRubric is the Schema of a number written with the precision of format
+func decimalRubric(format string) Schema {
+	decimals := map[string]int{FormatAmount: 2, FormatRate: 3, FormatQuotity: 2}[format]
+	return Schema{
+		Type:      TypeDecimal,
+		MinLength: decimals + 2,
+		MaxLength: 16,
+		Pattern:   regexp.MustCompile(fmt.Sprintf(`^-?[0-9]+\.[0-9]{%d}$`, decimals)),
+	}
+}
+
+// dateRubric is the Schema of a date
+func dateRubric() Schema {
+	return Schema{Type: TypeDate, MinLength: 8, MaxLength: 8}
+}
+
+// codeRubric is the Schema of a code, restricted to codes when given
+func codeRubric(minLength, maxLength int, codes ...string) Schema {
+	return Schema{Type: TypeCode, MinLength: minLength, MaxLength: maxLength, Pattern: codePattern, Codes: codes}
+}
+
+// patternRubric is the Schema of a value with a specific format
+func patternRubric(dataType DataType, minLength, maxLength int, pattern *regexp.Regexp) Schema {
+	return Schema{Type: dataType, MinLength: minLength, MaxLength: maxLength, Pattern: pattern}
+}
+
+// Validate checks that value, as written in the DSN, matches the Schema
+func (s Schema) Validate(value string) error {
+	length := utf8.RuneCountInString(value)
+	if length < s.MinLength || s.MaxLength > 0 && length > s.MaxLength {
+		return fmt.Errorf("length of %q is %d, expected between %d and %d", value, length, s.MinLength, s.MaxLength)
+	}
+
+	switch s.Type {
+	case TypeNumeric:
+		if strings.Trim(value, "0123456789") != "" {
+			return fmt.Errorf("%q is not numeric", value)
+		}
+	case TypeDate:
+		if s.Pattern == nil {
+			if _, err := time.Parse("20060102", value); err != nil {
+				return fmt.Errorf("%q is not a date", value)
+			}
+		}
+	}
+
+	if s.Pattern != nil && !s.Pattern.MatchString(value) {
+		return fmt.Errorf("%q does not match %s", value, s.Pattern)
+	}
+	if len(s.Codes) > 0 && !slices.Contains(s.Codes, value) {
+		return fmt.Errorf("%q is not one of %v", value, s.Codes)
+	}
+	return nil
 }
 
 // Blocs is a map of BlocIDs to their corresponding Blocs
@@ -87,582 +195,582 @@ var Blocs = map[BlocID]Bloc{
 }
 
 var Attributes = map[AttributeID]Attribute{
-	"S10.G00.00.001": {"S10.G00.00.001", "Nom du logiciel utilisé"},
-	"S10.G00.00.002": {"S10.G00.00.002", "Nom de l'éditeur"},
-	"S10.G00.00.003": {"S10.G00.00.003", "Numéro de version du logiciel utilisé"},
-	"S10.G00.00.004": {"S10.G00.00.004", "Code de conformité en pré-contrôle"},
-	"S10.G00.00.005": {"S10.G00.00.005", "Code envoi du fichier d'essai ou réel"},
-	"S10.G00.00.006": {"S10.G00.00.006", "Numéro de version de la norme utilisée"},
-	"S10.G00.00.007": {"S10.G00.00.007", "Point de dépôt"},
-	"S10.G00.00.008": {"S10.G00.00.008", "Type de l'envoi"},
-	"S10.G00.01.001": {"S10.G00.01.001", "Siren de l'émetteur de l'envoi"},
-	"S10.G00.01.002": {"S10.G00.01.002", "Nic de l'émetteur de l'envoi"},
-	"S10.G00.01.003": {"S10.G00.01.003", "Nom ou raison sociale de l'émetteur"},
-	"S10.G00.01.004": {"S10.G00.01.004", "Numéro, extension, nature et libellé de la voie"},
-	"S10.G00.01.005": {"S10.G00.01.005", "Code postal"},
-	"S10.G00.01.006": {"S10.G00.01.006", "Localité"},
-	"S10.G00.01.007": {"S10.G00.01.007", "Code pays"},
-	"S10.G00.01.008": {"S10.G00.01.008", "Code de distribution à l'étranger"},
-	"S10.G00.01.009": {"S10.G00.01.009", "Complément de la localisation de la construction"},
-	"S10.G00.01.010": {"S10.G00.01.010", "Service de distribution, complément de localisation de la voie"},
-	"S10.G00.02.001": {"S10.G00.02.001", "Code civilité"},
-	"S10.G00.02.002": {"S10.G00.02.002", "Nom et prénom de la personne à contacter"},
-	"S10.G00.02.004": {"S10.G00.02.004", "Adresse mél du contact émetteur"},
-	"S10.G00.02.005": {"S10.G00.02.005", "Adresse téléphonique"},
-	"S10.G00.02.006": {"S10.G00.02.006", "Adresse fax"},
-	"S20.G00.05.001": {"S20.G00.05.001", "Nature de la déclaration"},
-	"S20.G00.05.002": {"S20.G00.05.002", "Type de la déclaration"},
-	"S20.G00.05.003": {"S20.G00.05.003", "Numéro de fraction de déclaration"},
-	"S20.G00.05.004": {"S20.G00.05.004", "Numéro d'ordre de la déclaration"},
-	"S20.G00.05.005": {"S20.G00.05.005", "Date du mois principal déclaré"},
-	"S20.G00.05.006": {"S20.G00.05.006", "Identifiant de la déclaration annulée ou remplacée"},
-	"S20.G00.05.007": {"S20.G00.05.007", "Date de constitution du fichier"},
-	"S20.G00.05.008": {"S20.G00.05.008", "Champ de la déclaration"},
-	"S20.G00.05.009": {"S20.G00.05.009", "Identifiant métier"},
-	"S20.G00.05.010": {"S20.G00.05.010", "Devise de la déclaration"},
-	"S20.G00.05.011": {"S20.G00.05.011", "Nature de l’événement déclencheur du signalement "},
-	"S20.G00.05.012": {"S20.G00.05.012", "Dernier SIRET connu pour ancien numéro de contrat "},
-	"S20.G00.05.013": {"S20.G00.05.013", "Type de nature de DSN de substitution"},
-	"S20.G00.07.001": {"S20.G00.07.001", "Nom et prénom du contact"},
-	"S20.G00.07.002": {"S20.G00.07.002", "Adresse téléphonique"},
-	"S20.G00.07.003": {"S20.G00.07.003", "Adresse mél du contact"},
-	"S20.G00.07.004": {"S20.G00.07.004", "Type"},
-	"S20.G00.08.001": {"S20.G00.08.001", "Code caisse"},
-	"S21.G00.06.001": {"S21.G00.06.001", "SIREN"},
-	"S21.G00.06.002": {"S21.G00.06.002", "NIC du siège"},
-	"S21.G00.06.003": {"S21.G00.06.003", "Code APEN"},
-	"S21.G00.06.004": {"S21.G00.06.004", "Numéro, extension, nature et libellé de la voie"},
-	"S21.G00.06.005": {"S21.G00.06.005", "Code postal"},
-	"S21.G00.06.006": {"S21.G00.06.006", "Localité"},
-	"S21.G00.06.007": {"S21.G00.06.007", "Complément de la localisation de la construction"},
-	"S21.G00.06.008": {"S21.G00.06.008", "Service de distribution, complément de localisation de la voie"},
-	"S21.G00.06.009": {"S21.G00.06.009", "Effectif moyen de l'entreprise au 31 décembre"},
-	"S21.G00.06.010": {"S21.G00.06.010", "Code pays"},
-	"S21.G00.06.011": {"S21.G00.06.011", "Code de distribution à l'étranger"},
-	"S21.G00.06.012": {"S21.G00.06.012", "Implantation de l'entreprise"},
-	"S21.G00.06.015": {"S21.G00.06.015", "Code convention collective applicable"},
-	"S21.G00.11.001": {"S21.G00.11.001", "NIC"},
-	"S21.G00.11.002": {"S21.G00.11.002", "Code APET"},
-	"S21.G00.11.003": {"S21.G00.11.003", "Numéro, extension, nature et libellé de la voie"},
-	"S21.G00.11.004": {"S21.G00.11.004", "Code postal"},
-	"S21.G00.11.005": {"S21.G00.11.005", "Localité"},
-	"S21.G00.11.006": {"S21.G00.11.006", "Complément de la localisation de la construction"},
-	"S21.G00.11.007": {"S21.G00.11.007", "Service de distribution, complément de localisation de la voie"},
-	"S21.G00.11.008": {"S21.G00.11.008", "Effectif de fin de période déclarée de l'établissement"},
-	"S21.G00.11.009": {"S21.G00.11.009", "Type de rémunération soumise à contributions d'Assurance chômage pour expatriés"},
-	"S21.G00.11.015": {"S21.G00.11.015", "Code pays"},
-	"S21.G00.11.016": {"S21.G00.11.016", "Code de distribution à l'étranger"},
-	"S21.G00.11.017": {"S21.G00.11.017", "Nature juridique de l'employeur"},
-	"S21.G00.11.019": {"S21.G00.11.019", "Date d’effet de l’adhésion au dispositif TESE/CEA "},
-	"S21.G00.11.020": {"S21.G00.11.020", "Date d’effet de la sortie du dispositif TESE/CEA"},
-	"S21.G00.11.022": {"S21.G00.11.022", "Code convention collective principale"},
-	"S21.G00.11.023": {"S21.G00.11.023", "Opérateur de compétences (OPCO)"},
-	"S21.G00.11.024": {"S21.G00.11.024", "Demande de sortie de la DSN"},
-	"S21.G00.12.001": {"S21.G00.12.001", "Type d’usage"},
-	"S21.G00.12.002": {"S21.G00.12.002", "BIC"},
-	"S21.G00.12.003": {"S21.G00.12.003", "IBAN"},
-	"S21.G00.13.001": {"S21.G00.13.001", "Accord agréé OETH"},
-	"S21.G00.13.002": {"S21.G00.13.002", "Type BOETH externe"},
-	"S21.G00.13.003": {"S21.G00.13.003", "Nombre BOETH externe"},
-	"S21.G00.13.004": {"S21.G00.13.004", "Millésime de rattachement"},
-	"S21.G00.15.001": {"S21.G00.15.001", "Référence du contrat de Prévoyance"},
-	"S21.G00.15.002": {"S21.G00.15.002", "Code organisme de Prévoyance"},
-	"S21.G00.15.003": {"S21.G00.15.003", "Code délégataire de gestion"},
-	"S21.G00.15.004": {"S21.G00.15.004", "Personnel couvert"},
-	"S21.G00.15.005": {"S21.G00.15.005", "Identifiant technique Adhésion"},
-	"S21.G00.16.001": {"S21.G00.16.001", "Date de la modification"},
-	"S21.G00.16.002": {"S21.G00.16.002", "Ancien Code organisme de Prévoyance"},
-	"S21.G00.16.003": {"S21.G00.16.003", "Ancien Code délégataire de gestion"},
-	"S21.G00.20.001": {"S21.G00.20.001", "Identifiant Organisme de Protection Sociale"},
-	"S21.G00.20.002": {"S21.G00.20.002", "Entité d'affectation des opérations"},
-	"S21.G00.20.003": {"S21.G00.20.003", "BIC"},
-	"S21.G00.20.004": {"S21.G00.20.004", "IBAN"},
-	"S21.G00.20.005": {"S21.G00.20.005", "Montant du versement"},
-	"S21.G00.20.006": {"S21.G00.20.006", "Date de début de période de rattachement"},
-	"S21.G00.20.007": {"S21.G00.20.007", "Date de fin de période de rattachement"},
-	"S21.G00.20.008": {"S21.G00.20.008", "Code délégataire de gestion"},
-	"S21.G00.20.010": {"S21.G00.20.010", "Mode de paiement"},
-	"S21.G00.20.011": {"S21.G00.20.011", "Date de paiement"},
-	"S21.G00.20.012": {"S21.G00.20.012", "SIRET Payeur"},
-	"S21.G00.20.013": {"S21.G00.20.013", "Identifiant du CRM à l'origine de la régularisation"},
-	"S21.G00.20.014": {"S21.G00.20.014", "Identifiant du versement"},
-	"S21.G00.22.001": {"S21.G00.22.001", "Identifiant Organisme de Protection Sociale"},
-	"S21.G00.22.002": {"S21.G00.22.002", "Entité d'affectation des opérations"},
-	"S21.G00.22.003": {"S21.G00.22.003", "Date de début de période de rattachement"},
-	"S21.G00.22.004": {"S21.G00.22.004", "Date de fin de période de rattachement"},
-	"S21.G00.22.005": {"S21.G00.22.005", "Montant total de cotisations"},
-	"S21.G00.22.006": {"S21.G00.22.006", "Identifiant du CRM à l'origine de la régularisation"},
-	"S21.G00.23.001": {"S21.G00.23.001", "Code de cotisation"},
-	"S21.G00.23.002": {"S21.G00.23.002", "Qualifiant d'assiette"},
-	"S21.G00.23.003": {"S21.G00.23.003", "Taux de cotisation"},
-	"S21.G00.23.004": {"S21.G00.23.004", "Montant d'assiette"},
-	"S21.G00.23.005": {"S21.G00.23.005", "Montant de cotisation"},
-	"S21.G00.23.006": {"S21.G00.23.006", "Code INSEE commune"},
-	"S21.G00.23.007": {"S21.G00.23.007", "Identifiant du CRM à l'origine de la régularisation"},
-	"S21.G00.30.001": {"S21.G00.30.001", "Numéro d'inscription au répertoire"},
-	"S21.G00.30.002": {"S21.G00.30.002", "Nom de famille"},
-	"S21.G00.30.003": {"S21.G00.30.003", "Nom d'usage"},
-	"S21.G00.30.004": {"S21.G00.30.004", "Prénoms"},
-	"S21.G00.30.005": {"S21.G00.30.005", "Sexe"},
-	"S21.G00.30.006": {"S21.G00.30.006", "Date de naissance"},
-	"S21.G00.30.007": {"S21.G00.30.007", "Lieu de naissance"},
-	"S21.G00.30.008": {"S21.G00.30.008", "Numéro, extension, nature et libellé de la voie"},
-	"S21.G00.30.009": {"S21.G00.30.009", "Code postal"},
-	"S21.G00.30.010": {"S21.G00.30.010", "Localité"},
-	"S21.G00.30.011": {"S21.G00.30.011", "Code pays"},
-	"S21.G00.30.012": {"S21.G00.30.012", "Code de distribution à l'étranger"},
-	"S21.G00.30.013": {"S21.G00.30.013", "Codification UE"},
-	"S21.G00.30.014": {"S21.G00.30.014", "Code département de naissance"},
-	"S21.G00.30.015": {"S21.G00.30.015", "Code pays de naissance"},
-	"S21.G00.30.016": {"S21.G00.30.016", "Complément de la localisation de la construction"},
-	"S21.G00.30.017": {"S21.G00.30.017", "Service de distribution, complément de localisation de la voie"},
-	"S21.G00.30.018": {"S21.G00.30.018", "Adresse mél"},
-	"S21.G00.30.019": {"S21.G00.30.019", "Matricule de l'individu dans l'entreprise"},
-	"S21.G00.30.020": {"S21.G00.30.020", "Numéro technique temporaire"},
-	"S21.G00.30.022": {"S21.G00.30.022", "Statut à l'étranger au sens fiscal"},
-	"S21.G00.30.023": {"S21.G00.30.023", "Cumul emploi retraite"},
-	"S21.G00.30.024": {"S21.G00.30.024", "Niveau de formation le plus élevé obtenu par l'individu"},
-	"S21.G00.30.025": {"S21.G00.30.025", "Niveau de diplôme préparé par l'individu"},
-	"S21.G00.30.029": {"S21.G00.30.029", "Libellé du pays de naissance"},
-	"S21.G00.31.001": {"S21.G00.31.001", "Date de la modification"},
-	"S21.G00.31.008": {"S21.G00.31.008", "Ancien NIR"},
-	"S21.G00.31.009": {"S21.G00.31.009", "Ancien nom de famille"},
-	"S21.G00.31.010": {"S21.G00.31.010", "Anciens prénoms"},
-	"S21.G00.31.011": {"S21.G00.31.011", "Ancienne date de naissance"},
-	"S21.G00.34.001": {"S21.G00.34.001", "Facteur d'exposition"},
-	"S21.G00.34.002": {"S21.G00.34.002", "Numéro du contrat"},
-	"S21.G00.34.003": {"S21.G00.34.003", "Année de rattachement"},
-	"S21.G00.40.001": {"S21.G00.40.001", "Date de début du contrat"},
-	"S21.G00.40.002": {"S21.G00.40.002", "Statut du salarié (conventionnel)"},
-	"S21.G00.40.003": {"S21.G00.40.003", "Code statut catégoriel Retraite Complémentaire obligatoire"},
-	"S21.G00.40.004": {"S21.G00.40.004", "Code profession et catégorie socioprofessionnelle (PCS-ESE)"},
-	"S21.G00.40.005": {"S21.G00.40.005", "Code complément PCS-ESE"},
-	"S21.G00.40.006": {"S21.G00.40.006", "Libellé de l'emploi"},
-	"S21.G00.40.007": {"S21.G00.40.007", "Nature du contrat"},
-	"S21.G00.40.008": {"S21.G00.40.008", "Dispositif de politique publique et conventionnel"},
-	"S21.G00.40.009": {"S21.G00.40.009", "Numéro du contrat"},
-	"S21.G00.40.010": {"S21.G00.40.010", "Date de fin prévisionnelle du contrat"},
-	"S21.G00.40.011": {"S21.G00.40.011", "Unité de mesure de la quotité de travail"},
-	"S21.G00.40.012": {"S21.G00.40.012", "Quotité de travail de référence de l'entreprise pour la catégorie de salarié"},
-	"S21.G00.40.013": {"S21.G00.40.013", "Quotité de travail du contrat"},
-	"S21.G00.40.014": {"S21.G00.40.014", "Modalité d'exercice du temps de travail"},
-	"S21.G00.40.016": {"S21.G00.40.016", "Complément de base au régime obligatoire"},
-	"S21.G00.40.017": {"S21.G00.40.017", "Code convention collective applicable"},
-	"S21.G00.40.018": {"S21.G00.40.018", "Code régime de base risque maladie"},
-	"S21.G00.40.019": {"S21.G00.40.019", "Identifiant du lieu de travail"},
-	"S21.G00.40.020": {"S21.G00.40.020", "Code régime de base risque vieillesse"},
-	"S21.G00.40.021": {"S21.G00.40.021", "Motif de recours"},
-	"S21.G00.40.022": {"S21.G00.40.022", "Code caisse professionnelle de congés payés"},
-	"S21.G00.40.023": {"S21.G00.40.023", "Taux de déduction forfaitaire spécifique pour frais professionnels"},
-	"S21.G00.40.024": {"S21.G00.40.024", "Travailleur à l'étranger au sens du code de la Sécurité Sociale"},
-	"S21.G00.40.025": {"S21.G00.40.025", "Motif d'exclusion DSN"},
-	"S21.G00.40.026": {"S21.G00.40.026", "Statut d'emploi du salarié"},
-	"S21.G00.40.027": {"S21.G00.40.027", "Code affectation Assurance chômage"},
-	"S21.G00.40.028": {"S21.G00.40.028", "Numéro interne employeur public"},
-	"S21.G00.40.029": {"S21.G00.40.029", "Type de gestion de l'Assurance chômage"},
-	"S21.G00.40.030": {"S21.G00.40.030", "Date d'adhésion"},
-	"S21.G00.40.031": {"S21.G00.40.031", "Date de dénonciation"},
-	"S21.G00.40.032": {"S21.G00.40.032", "Date d'effet de la convention de gestion"},
-	"S21.G00.40.033": {"S21.G00.40.033", "Numéro de convention de gestion"},
-	"S21.G00.40.035": {"S21.G00.40.035", "Code délégataire du risque maladie"},
-	"S21.G00.40.036": {"S21.G00.40.036", "Code emplois multiples"},
-	"S21.G00.40.037": {"S21.G00.40.037", "Code employeurs multiples"},
-	"S21.G00.40.039": {"S21.G00.40.039", "Code régime de base risque accident du travail"},
-	"S21.G00.40.040": {"S21.G00.40.040", "Code risque accident du travail"},
-	"S21.G00.40.041": {"S21.G00.40.041", "Positionnement dans la convention collective"},
-	"S21.G00.40.042": {"S21.G00.40.042", "Code statut catégoriel APECITA"},
-	"S21.G00.40.043": {"S21.G00.40.043", "Taux de cotisation accident du travail"},
-	"S21.G00.40.044": {"S21.G00.40.044", "Salarié à temps partiel cotisant à temps plein"},
-	"S21.G00.40.045": {"S21.G00.40.045", "Rémunération au pourboire"},
-	"S21.G00.40.046": {"S21.G00.40.046", "Identifiant de l'établissement utilisateur"},
-	"S21.G00.40.048": {"S21.G00.40.048", "Numéro de label « Prestataire de services du spectacle vivant »"},
-	"S21.G00.40.049": {"S21.G00.40.049", "Numéro de licence entrepreneur spectacle"},
-	"S21.G00.40.050": {"S21.G00.40.050", "Numéro objet spectacle"},
-	"S21.G00.40.051": {"S21.G00.40.051", "Statut organisateur spectacle"},
-	"S21.G00.40.052": {"S21.G00.40.052", "[FP] Code complément PCS-ESE pour la fonction publique d'Etat (NNE)"},
-	"S21.G00.40.053": {"S21.G00.40.053", "Nature du poste"},
-	"S21.G00.40.054": {"S21.G00.40.054", "[FP] Quotité de travail de référence de l'entreprise pour la catégorie de salarié dans l’hypothèse d’un poste à temps complet"},
-	"S21.G00.40.055": {"S21.G00.40.055", "Taux de travail à temps partiel"},
-	"S21.G00.40.056": {"S21.G00.40.056", "Code catégorie de service"},
-	"S21.G00.40.057": {"S21.G00.40.057", "[FP] Indice brut"},
-	"S21.G00.40.058": {"S21.G00.40.058", "[FP] Indice majoré"},
-	"S21.G00.40.059": {"S21.G00.40.059", "[FP] Nouvelle bonification indiciaire (NBI)"},
-	"S21.G00.40.060": {"S21.G00.40.060", "[FP] Indice brut d'origine"},
-	"S21.G00.40.061": {"S21.G00.40.061", "[FP] Indice brut de cotisation dans un emploi supérieur (article 15)"},
-	"S21.G00.40.062": {"S21.G00.40.062", "[FP] Ancien employeur public "},
-	"S21.G00.40.063": {"S21.G00.40.063", "[FP] Indice brut d’origine ancien salarié employeur public"},
-	"S21.G00.40.064": {"S21.G00.40.064", "[FP] Indice brut d’origine sapeur-pompier professionnel (SPP)"},
-	"S21.G00.40.065": {"S21.G00.40.065", "[FP] Maintien du traitement d'origine d'un contractuel titulaire"},
-	"S21.G00.40.066": {"S21.G00.40.066", "[FP] Type de détachement"},
-	"S21.G00.40.067": {"S21.G00.40.067", "Genre de navigation"},
-	"S21.G00.40.068": {"S21.G00.40.068", "Taux de service actif "},
-	"S21.G00.40.069": {"S21.G00.40.069", "Niveau de rémunération"},
-	"S21.G00.40.070": {"S21.G00.40.070", "Echelon"},
-	"S21.G00.40.071": {"S21.G00.40.071", "Coefficient hiérarchique "},
-	"S21.G00.40.072": {"S21.G00.40.072", "Statut BOETH"},
-	"S21.G00.40.073": {"S21.G00.40.073", "Complément de dispositif de politique publique"},
-	"S21.G00.40.074": {"S21.G00.40.074", "Cas de mise à disposition externe d'un individu de l'établissement"},
-	"S21.G00.40.075": {"S21.G00.40.075", "Catégorie de classement finale "},
-	"S21.G00.40.076": {"S21.G00.40.076", "Identifiant du contrat d'engagement maritime"},
-	"S21.G00.40.077": {"S21.G00.40.077", "Collège (CNIEG)"},
-	"S21.G00.40.078": {"S21.G00.40.078", "Forme d'aménagement du temps de travail dans le cadre de l'activité partielle"},
-	"S21.G00.40.079": {"S21.G00.40.079", "Grade"},
-	"S21.G00.40.080": {"S21.G00.40.080", "[FP] Indice complément de traitement indiciaire (CTI)"},
-	"S21.G00.40.081": {"S21.G00.40.081", "FINESS géographique"},
-	"S21.G00.41.001": {"S21.G00.41.001", "Date de la modification"},
-	"S21.G00.41.002": {"S21.G00.41.002", "Ancien statut du salarié (conventionnel)"},
-	"S21.G00.41.003": {"S21.G00.41.003", "Ancien code statut catégoriel Retraite Complémentaire obligatoire"},
-	"S21.G00.41.004": {"S21.G00.41.004", "Ancienne nature du contrat"},
-	"S21.G00.41.005": {"S21.G00.41.005", "Ancien dispositif de politique publique et conventionnel"},
-	"S21.G00.41.006": {"S21.G00.41.006", "Ancienne unité de mesure de la quotité de travail"},
-	"S21.G00.41.007": {"S21.G00.41.007", "Ancienne quotité de travail du contrat"},
-	"S21.G00.41.008": {"S21.G00.41.008", "Ancienne modalité d'exercice du temps de travail"},
-	"S21.G00.41.010": {"S21.G00.41.010", "Ancien complément de base au régime obligatoire"},
-	"S21.G00.41.011": {"S21.G00.41.011", "Ancien code convention collective applicable"},
-	"S21.G00.41.012": {"S21.G00.41.012", "SIRET ancien établissement d'affectation"},
-	"S21.G00.41.013": {"S21.G00.41.013", "Ancien identifiant du lieu de travail"},
-	"S21.G00.41.014": {"S21.G00.41.014", "Ancien numéro du contrat"},
-	"S21.G00.41.016": {"S21.G00.41.016", "Ancien motif de recours"},
-	"S21.G00.41.017": {"S21.G00.41.017", "Ancien taux de déduction forfaitaire spécifique pour frais professionnels"},
-	"S21.G00.41.018": {"S21.G00.41.018", "Ancien travailleur à l'étranger au sens du code de la Sécurité Sociale"},
-	"S21.G00.41.019": {"S21.G00.41.019", "Ancien code profession et catégorie socioprofessionnelle (PCS-ESE)"},
-	"S21.G00.41.020": {"S21.G00.41.020", "Ancien code complément PCS-ESE"},
-	"S21.G00.41.021": {"S21.G00.41.021", "Ancienne date de début du contrat"},
-	"S21.G00.41.022": {"S21.G00.41.022", "Ancienne quotité de travail de référence de l'entreprise pour la catégorie de salarié"},
-	"S21.G00.41.023": {"S21.G00.41.023", "Ancien code caisse professionnelle de congés payés"},
-	"S21.G00.41.024": {"S21.G00.41.024", "Ancien code risque accident du travail"},
-	"S21.G00.41.025": {"S21.G00.41.025", "Ancien code statut catégoriel APECITA"},
-	"S21.G00.41.027": {"S21.G00.41.027", "Ancien salarié à temps partiel cotisant à temps plein"},
-	"S21.G00.41.028": {"S21.G00.41.028", "Profondeur de recalcul de la paie "},
-	"S21.G00.41.029": {"S21.G00.41.029", "[FP] Ancien code complément PCS-ESE pour la fonction publique d'Etat (NNE)"},
-	"S21.G00.41.030": {"S21.G00.41.030", "Ancienne nature du poste"},
-	"S21.G00.41.031": {"S21.G00.41.031", "[FP] Ancienne quotité de travail de référence de l'entreprise pour la catégorie de salarié dans l’hypothèse d’un poste à temps complet"},
-	"S21.G00.41.032": {"S21.G00.41.032", "Ancien taux de travail à temps partiel"},
-	"S21.G00.41.033": {"S21.G00.41.033", "Ancien code catégorie de service"},
-	"S21.G00.41.034": {"S21.G00.41.034", "[FP] Ancien indice brut"},
-	"S21.G00.41.035": {"S21.G00.41.035", "[FP] Ancien indice majoré"},
-	"S21.G00.41.036": {"S21.G00.41.036", "[FP] Ancienne nouvelle bonification indiciaire (NBI)"},
-	"S21.G00.41.037": {"S21.G00.41.037", "[FP] Ancien indice brut d'origine"},
-	"S21.G00.41.038": {"S21.G00.41.038", "[FP] Ancien indice brut de cotisation dans un emploi supérieur (article 15)"},
-	"S21.G00.41.039": {"S21.G00.41.039", "[FP] Ancien ancien employeur public"},
-	"S21.G00.41.040": {"S21.G00.41.040", "[FP] Ancien indice brut d’origine ancien salarié employeur public"},
-	"S21.G00.41.041": {"S21.G00.41.041", "[FP] Ancien indice brut d’origine sapeur-pompier professionnel (SPP)"},
-	"S21.G00.41.042": {"S21.G00.41.042", "[FP] Ancien maintien du traitement d'origine d'un contractuel titulaire"},
-	"S21.G00.41.043": {"S21.G00.41.043", "Ancien taux de service actif"},
-	"S21.G00.41.044": {"S21.G00.41.044", "Ancien niveau de rémunération"},
-	"S21.G00.41.045": {"S21.G00.41.045", "Ancien échelon"},
-	"S21.G00.41.046": {"S21.G00.41.046", "Ancien coefficient hiérarchique "},
-	"S21.G00.41.047": {"S21.G00.41.047", "Ancien genre de navigation"},
-	"S21.G00.41.048": {"S21.G00.41.048", "Ancien statut BOETH"},
-	"S21.G00.41.049": {"S21.G00.41.049", "Ancien complément de dispositif de politique publique"},
-	"S21.G00.41.050": {"S21.G00.41.050", "Ancien cas de mise à disposition externe d'un individu de l'établissement"},
-	"S21.G00.41.051": {"S21.G00.41.051", "Ancienne catégorie de classement finale"},
-	"S21.G00.41.052": {"S21.G00.41.052", "Ancien code régime de base risque maladie"},
-	"S21.G00.41.053": {"S21.G00.41.053", "Ancien code régime de base risque vieillesse"},
-	"S21.G00.41.054": {"S21.G00.41.054", "Ancien identifiant du contrat d'engagement maritime"},
-	"S21.G00.41.055": {"S21.G00.41.055", "Ancien collège (CNIEG) "},
-	"S21.G00.41.056": {"S21.G00.41.056", "Ancienne forme d'aménagement du temps de travail dans le cadre de l'activité partielle"},
-	"S21.G00.41.057": {"S21.G00.41.057", "[FP] Ancien type de détachement"},
-	"S21.G00.41.058": {"S21.G00.41.058", "Ancien positionnement dans la convention collective"},
-	"S21.G00.41.059": {"S21.G00.41.059", "Ancien code régime de base risque accident du travail"},
-	"S21.G00.41.060": {"S21.G00.41.060", "Ancien statut d'emploi du salarié"},
-	"S21.G00.41.061": {"S21.G00.41.061", "Ancien code emplois multiples"},
-	"S21.G00.41.062": {"S21.G00.41.062", "Ancien code employeurs multiples"},
-	"S21.G00.41.063": {"S21.G00.41.063", "Ancien grade"},
-	"S21.G00.41.064": {"S21.G00.41.064", "[FP] Ancien indice complément de traitement indiciaire (CTI)"},
-	"S21.G00.41.065": {"S21.G00.41.065", "Ancien FINESS géographique"},
-	"S21.G00.44.001": {"S21.G00.44.001", "Code taxe"},
-	"S21.G00.44.002": {"S21.G00.44.002", "Montant"},
-	"S21.G00.44.003": {"S21.G00.44.003", "Millésime de rattachement"},
-	"S21.G00.44.004": {"S21.G00.44.004", "Motif de non assujettissement à la taxe d'apprentissage"},
-	"S21.G00.45.001": {"S21.G00.45.001", "SIRET déclarant le contrat précédemment"},
-	"S21.G00.45.002": {"S21.G00.45.002", "Numéro du contrat déclaré précédemment"},
-	"S21.G00.50.001": {"S21.G00.50.001", "Date de versement"},
-	"S21.G00.50.002": {"S21.G00.50.002", "Rémunération nette fiscale"},
-	"S21.G00.50.003": {"S21.G00.50.003", "Numéro de versement"},
-	"S21.G00.50.004": {"S21.G00.50.004", "Montant net versé"},
-	"S21.G00.50.006": {"S21.G00.50.006", "Taux de prélèvement à la source"},
-	"S21.G00.50.007": {"S21.G00.50.007", "Type du taux de prélèvement à la source"},
-	"S21.G00.50.008": {"S21.G00.50.008", "Identifiant du taux de prélèvement à la source"},
-	"S21.G00.50.009": {"S21.G00.50.009", "Montant de prélèvement à la source"},
-	"S21.G00.50.011": {"S21.G00.50.011", "Montant de la part non imposable du revenu"},
-	"S21.G00.50.012": {"S21.G00.50.012", "Montant de l’abattement sur la base fiscale (non déduit de la rémunération nette fiscale)"},
-	"S21.G00.50.013": {"S21.G00.50.013", "Montant soumis au PAS "},
-	"S21.G00.50.020": {"S21.G00.50.020", "Mois de la DSN mensuelle de rattachement des éléments déclarés dans le FCTU"},
-	"S21.G00.51.001": {"S21.G00.51.001", "Date de début de période de paie"},
-	"S21.G00.51.002": {"S21.G00.51.002", "Date de fin de période de paie"},
-	"S21.G00.51.010": {"S21.G00.51.010", "Numéro du contrat"},
-	"S21.G00.51.011": {"S21.G00.51.011", "Type"},
-	"S21.G00.51.012": {"S21.G00.51.012", "Nombre d'heures"},
-	"S21.G00.51.013": {"S21.G00.51.013", "Montant"},
-	"S21.G00.51.014": {"S21.G00.51.014", "[FP] Taux de rémunération de la situation administrative"},
-	"S21.G00.51.015": {"S21.G00.51.015", "Taux de conduite centrale nucléaire "},
-	"S21.G00.51.016": {"S21.G00.51.016", "Taux de majoration"},
-	"S21.G00.51.019": {"S21.G00.51.019", "Taux de rémunération cotisée"},
-	"S21.G00.51.020": {"S21.G00.51.020", "Taux de majoration ex-apprenti/ex-élève"},
-	"S21.G00.52.001": {"S21.G00.52.001", "Type"},
-	"S21.G00.52.002": {"S21.G00.52.002", "Montant"},
-	"S21.G00.52.003": {"S21.G00.52.003", "Date de début de la période de rattachement"},
-	"S21.G00.52.004": {"S21.G00.52.004", "Date de fin de la période de rattachement"},
-	"S21.G00.52.006": {"S21.G00.52.006", "Numéro du contrat"},
-	"S21.G00.52.007": {"S21.G00.52.007", "Date de versement d'origine"},
-	"S21.G00.53.001": {"S21.G00.53.001", "Type"},
-	"S21.G00.53.002": {"S21.G00.53.002", "Mesure"},
-	"S21.G00.53.003": {"S21.G00.53.003", "Unité de mesure"},
-	"S21.G00.54.001": {"S21.G00.54.001", "Type"},
-	"S21.G00.54.002": {"S21.G00.54.002", "Montant"},
-	"S21.G00.54.003": {"S21.G00.54.003", "Date de début de période de rattachement"},
-	"S21.G00.54.004": {"S21.G00.54.004", "Date de fin de période de rattachement"},
-	"S21.G00.55.001": {"S21.G00.55.001", "Montant versé"},
-	"S21.G00.55.002": {"S21.G00.55.002", "Type de population"},
-	"S21.G00.55.003": {"S21.G00.55.003", "Code d'affectation"},
-	"S21.G00.55.004": {"S21.G00.55.004", "Période d'affectation"},
-	"S21.G00.55.005": {"S21.G00.55.005", "Identifiant du CRM à l'origine de la régularisation"},
-	"S21.G00.56.001": {"S21.G00.56.001", "Mois de l'erreur "},
-	"S21.G00.56.002": {"S21.G00.56.002", "Type d'erreur"},
-	"S21.G00.56.003": {"S21.G00.56.003", "Régularisation de la rémunération nette fiscale"},
-	"S21.G00.56.004": {"S21.G00.56.004", "Rémunération nette fiscale déclarée le mois de l’erreur"},
-	"S21.G00.56.005": {"S21.G00.56.005", "Régularisation du taux de prélèvement à la source "},
-	"S21.G00.56.006": {"S21.G00.56.006", "Taux déclaré le mois de l'erreur"},
-	"S21.G00.56.007": {"S21.G00.56.007", "Montant de la régularisation du prélèvement à la source"},
-	"S21.G00.56.008": {"S21.G00.56.008", "Régularisation du montant de la part non imposable du revenu"},
-	"S21.G00.56.009": {"S21.G00.56.009", "Régularisation du montant de l’abattement sur la base fiscale (non déduit de la rémunération nette fiscale)"},
-	"S21.G00.56.010": {"S21.G00.56.010", "Régularisation du montant soumis au PAS"},
-	"S21.G00.56.015": {"S21.G00.56.015", "Montant soumis au prélèvement à la source déclaré le mois de l'erreur"},
-	"S21.G00.58.001": {"S21.G00.58.001", "Date de début de période de rattachement"},
-	"S21.G00.58.002": {"S21.G00.58.002", "Date de fin de période de rattachement"},
-	"S21.G00.58.003": {"S21.G00.58.003", "Type"},
-	"S21.G00.58.004": {"S21.G00.58.004", "Montant"},
-	"S21.G00.60.001": {"S21.G00.60.001", "Motif de l'arrêt"},
-	"S21.G00.60.002": {"S21.G00.60.002", "Date du dernier jour travaillé"},
-	"S21.G00.60.003": {"S21.G00.60.003", "Date de fin prévisionnelle"},
-	"S21.G00.60.004": {"S21.G00.60.004", "Subrogation"},
-	"S21.G00.60.005": {"S21.G00.60.005", "Date de début de subrogation"},
-	"S21.G00.60.006": {"S21.G00.60.006", "Date de fin de subrogation"},
-	"S21.G00.60.007": {"S21.G00.60.007", "IBAN"},
-	"S21.G00.60.008": {"S21.G00.60.008", "BIC"},
-	"S21.G00.60.010": {"S21.G00.60.010", "Date de la reprise"},
-	"S21.G00.60.011": {"S21.G00.60.011", "Motif de la reprise"},
-	"S21.G00.60.012": {"S21.G00.60.012", "Date de l'accident ou de la première constatation"},
-	"S21.G00.60.600": {"S21.G00.60.600", "SIRET Centralisateur"},
-	"S21.G00.62.001": {"S21.G00.62.001", "Date de fin du contrat"},
-	"S21.G00.62.002": {"S21.G00.62.002", "Motif de la rupture du contrat"},
-	"S21.G00.62.003": {"S21.G00.62.003", "Date de notification de la rupture de contrat"},
-	"S21.G00.62.004": {"S21.G00.62.004", "Date de signature de la convention de rupture"},
-	"S21.G00.62.005": {"S21.G00.62.005", "Date d'engagement de la procédure de licenciement"},
-	"S21.G00.62.006": {"S21.G00.62.006", "Dernier jour travaillé et payé au salaire habituel"},
-	"S21.G00.62.008": {"S21.G00.62.008", "Transaction en cours"},
-	"S21.G00.62.011": {"S21.G00.62.011", "Nombre de mois de préavis utilisés dans le cadre du calcul CSP"},
-	"S21.G00.62.013": {"S21.G00.62.013", "Montant de l'indemnité de préavis qui aurait été versée"},
-	"S21.G00.62.014": {"S21.G00.62.014", "Statut particulier du salarié"},
-	"S21.G00.62.016": {"S21.G00.62.016", "Maintien de l'affiliation du salarié au contrat collectif "},
-	"S21.G00.62.017": {"S21.G00.62.017", "Modalité de déclaration de la fin du contrat d'usage"},
-	"S21.G00.62.018": {"S21.G00.62.018", "Nombre de mois de préavis utilisés dans le cadre du calcul PAP"},
-	"S21.G00.62.019": {"S21.G00.62.019", "Solde de congés acquis et non pris (ENIM)"},
-	"S21.G00.62.020": {"S21.G00.62.020", "Mois de la DSN mensuelle portant les derniers éléments déclarés dans le FCTU"},
-	"S21.G00.63.001": {"S21.G00.63.001", "Type réalisation et paiement du préavis"},
-	"S21.G00.63.002": {"S21.G00.63.002", "Date de début de préavis"},
-	"S21.G00.63.003": {"S21.G00.63.003", "Date de fin de préavis"},
-	"S21.G00.65.001": {"S21.G00.65.001", "Motif de suspension"},
-	"S21.G00.65.002": {"S21.G00.65.002", "Date de début de la suspension"},
-	"S21.G00.65.003": {"S21.G00.65.003", "Date de fin de la suspension"},
-	"S21.G00.65.004": {"S21.G00.65.004", "[FP] Position de détachement"},
-	"S21.G00.65.005": {"S21.G00.65.005", "Nombre de jours ouvrés de suspension"},
-	"S21.G00.66.001": {"S21.G00.66.001", "Date de début"},
-	"S21.G00.66.002": {"S21.G00.66.002", "Date de fin"},
-	"S21.G00.66.003": {"S21.G00.66.003", "Montant"},
-	"S21.G00.70.004": {"S21.G00.70.004", "Code option retenue par le salarié"},
-	"S21.G00.70.005": {"S21.G00.70.005", "Code population de rattachement"},
-	"S21.G00.70.007": {"S21.G00.70.007", "Nombre d'enfants à charge"},
-	"S21.G00.70.008": {"S21.G00.70.008", "Nombre d'adultes ayants-droit (conjoint, concubin, ...)"},
-	"S21.G00.70.009": {"S21.G00.70.009", "Nombre d'ayants-droit"},
-	"S21.G00.70.010": {"S21.G00.70.010", "Nombre d'ayants-droit autres (ascendants, collatéraux...)"},
-	"S21.G00.70.011": {"S21.G00.70.011", "Nombre d'enfants ayants-droit"},
-	"S21.G00.70.012": {"S21.G00.70.012", "Identifiant technique Affiliation"},
-	"S21.G00.70.013": {"S21.G00.70.013", "Identifiant technique Adhésion"},
-	"S21.G00.70.014": {"S21.G00.70.014", "Date de début de l'affiliation"},
-	"S21.G00.70.015": {"S21.G00.70.015", "Date de fin de l'affiliation"},
-	"S21.G00.71.002": {"S21.G00.71.002", "Code régime Retraite Complémentaire"},
-	"S21.G00.71.003": {"S21.G00.71.003", "Référence adhésion employeur"},
-	"S21.G00.72.001": {"S21.G00.72.001", "Code régime Retraite Complémentaire déclaré à tort"},
-	"S21.G00.72.002": {"S21.G00.72.002", "Référence adhésion employeur déclarée à tort"},
-	"S21.G00.73.001": {"S21.G00.73.001", "Régime local Alsace-Moselle"},
-	"S21.G00.73.002": {"S21.G00.73.002", "Code option"},
-	"S21.G00.73.003": {"S21.G00.73.003", "Type"},
-	"S21.G00.73.004": {"S21.G00.73.004", "Date de début de rattachement à l'ouvrant-droit"},
-	"S21.G00.73.005": {"S21.G00.73.005", "Date de naissance"},
-	"S21.G00.73.006": {"S21.G00.73.006", "Nom de famille"},
-	"S21.G00.73.007": {"S21.G00.73.007", "Numéro d'inscription au répertoire"},
-	"S21.G00.73.008": {"S21.G00.73.008", "NIR ouvrant-droit régime de base maladie"},
-	"S21.G00.73.009": {"S21.G00.73.009", "Prénoms"},
-	"S21.G00.73.010": {"S21.G00.73.010", "Code organisme d'affiliation à l'assurance maladie"},
-	"S21.G00.73.011": {"S21.G00.73.011", "Date de fin de rattachement à l'ouvrant-droit"},
-	"S21.G00.78.001": {"S21.G00.78.001", "Code de base assujettie"},
-	"S21.G00.78.002": {"S21.G00.78.002", "Date de début de période de rattachement"},
-	"S21.G00.78.003": {"S21.G00.78.003", "Date de fin de période de rattachement"},
-	"S21.G00.78.004": {"S21.G00.78.004", "Montant"},
-	"S21.G00.78.005": {"S21.G00.78.005", "Identifiant technique Affiliation"},
-	"S21.G00.78.006": {"S21.G00.78.006", "Numéro du contrat"},
-	"S21.G00.78.007": {"S21.G00.78.007", "Identifiant du CRM à l'origine de la régularisation"},
-	"S21.G00.79.001": {"S21.G00.79.001", "Type de composant de base assujettie"},
-	"S21.G00.79.004": {"S21.G00.79.004", "Montant de composant de base assujettie"},
-	"S21.G00.79.005": {"S21.G00.79.005", "Identifiant du CRM à l'origine de la régularisation"},
-	"S21.G00.81.001": {"S21.G00.81.001", "Code de cotisation"},
-	"S21.G00.81.002": {"S21.G00.81.002", "Identifiant Organisme de Protection Sociale"},
-	"S21.G00.81.003": {"S21.G00.81.003", "Montant d'assiette"},
-	"S21.G00.81.004": {"S21.G00.81.004", "Montant de cotisation"},
-	"S21.G00.81.005": {"S21.G00.81.005", "Code INSEE commune"},
-	"S21.G00.81.006": {"S21.G00.81.006", "Identifiant du CRM à l'origine de la régularisation"},
-	"S21.G00.81.007": {"S21.G00.81.007", "Taux de cotisation"},
-	"S21.G00.82.001": {"S21.G00.82.001", "Valeur"},
-	"S21.G00.82.002": {"S21.G00.82.002", "Code de cotisation"},
-	"S21.G00.82.003": {"S21.G00.82.003", "Date de début de période de rattachement"},
-	"S21.G00.82.004": {"S21.G00.82.004", "Date de fin de période de rattachement"},
-	"S21.G00.82.005": {"S21.G00.82.005", "Référence réglementaire ou contractuelle"},
-	"S21.G00.82.006": {"S21.G00.82.006", "Identifiant du CRM à l'origine de la régularisation"},
-	"S21.G00.83.001": {"S21.G00.83.001", "Date de début de période déclarée à tort"},
-	"S21.G00.83.002": {"S21.G00.83.002", "Date de fin de période déclarée à tort "},
-	"S21.G00.84.001": {"S21.G00.84.001", "Code de base assujettie déclarée à tort"},
-	"S21.G00.84.002": {"S21.G00.84.002", "Date de début de période de rattachement de la base déclarée à tort"},
-	"S21.G00.84.003": {"S21.G00.84.003", "Date de fin de période de rattachement de la base déclarée à tort"},
-	"S21.G00.84.004": {"S21.G00.84.004", "Montant déclaré à tort"},
-	"S21.G00.84.005": {"S21.G00.84.005", "Numéro du contrat rattaché à la base assujettie déclarée à tort"},
-	"S21.G00.85.001": {"S21.G00.85.001", "Identifiant du lieu de travail ou de l'établissement utilisateur"},
-	"S21.G00.85.002": {"S21.G00.85.002", "Code APET"},
-	"S21.G00.85.003": {"S21.G00.85.003", "Numéro, extension, nature, libellé de voie"},
-	"S21.G00.85.004": {"S21.G00.85.004", "Code postal"},
-	"S21.G00.85.005": {"S21.G00.85.005", "Localité"},
-	"S21.G00.85.006": {"S21.G00.85.006", "Code Pays"},
-	"S21.G00.85.007": {"S21.G00.85.007", "Code de distribution à l'étranger"},
-	"S21.G00.85.008": {"S21.G00.85.008", "Complément de la localisation de la construction"},
-	"S21.G00.85.009": {"S21.G00.85.009", "Service de distribution, complément de localisation de la voie"},
-	"S21.G00.85.010": {"S21.G00.85.010", "Nature juridique"},
-	"S21.G00.85.011": {"S21.G00.85.011", "Code INSEE commune"},
-	"S21.G00.85.012": {"S21.G00.85.012", "Code civilité"},
-	"S21.G00.85.013": {"S21.G00.85.013", "Nom de famille"},
-	"S21.G00.85.014": {"S21.G00.85.014", "Nom d'usage"},
-	"S21.G00.85.015": {"S21.G00.85.015", "Prénoms"},
-	"S21.G00.85.016": {"S21.G00.85.016", "Date de naissance"},
-	"S21.G00.85.017": {"S21.G00.85.017", "Lieu de naissance"},
-	"S21.G00.86.001": {"S21.G00.86.001", "Type"},
-	"S21.G00.86.002": {"S21.G00.86.002", "Unité de mesure"},
-	"S21.G00.86.003": {"S21.G00.86.003", "Valeur"},
-	"S21.G00.86.005": {"S21.G00.86.005", "Numéro du contrat"},
-	"S21.G00.95.001": {"S21.G00.95.001", "Code de base assujettie déclarée à tort"},
-	"S21.G00.95.002": {"S21.G00.95.002", "Date de début de période de rattachement de la base déclarée à tort"},
-	"S21.G00.95.003": {"S21.G00.95.003", "Date de fin de période de rattachement de la base déclarée à tort"},
-	"S21.G00.95.004": {"S21.G00.95.004", "Montant déclaré à tort"},
-	"S21.G00.95.005": {"S21.G00.95.005", "Numéro du contrat rattaché à la base assujettie déclarée à tort"},
-	"S21.G00.98.001": {"S21.G00.98.001", "Identifiant de la SATD"},
-	"S21.G00.98.002": {"S21.G00.98.002", "Etat de prise en compte de la SATD"},
-	"S21.G00.98.003": {"S21.G00.98.003", "Montant"},
-	"S21.G00.98.004": {"S21.G00.98.004", "Mois de l’erreur"},
-	"S89.G00.32.001": {"S89.G00.32.001", "Profession ou qualité"},
-	"S89.G00.32.002": {"S89.G00.32.002", "Nom du bénéficiaire des honoraires"},
-	"S89.G00.32.003": {"S89.G00.32.003", "Prénom du bénéficiaire des honoraires"},
-	"S89.G00.32.004": {"S89.G00.32.004", "Siren du bénéficiaire des honoraires"},
-	"S89.G00.32.005": {"S89.G00.32.005", "Nic du bénéficiaire des honoraires"},
-	"S89.G00.32.006": {"S89.G00.32.006", "Raison sociale du bénéficiaire des honoraires"},
-	"S89.G00.32.007": {"S89.G00.32.007", "Complément de localisation de la construction"},
-	"S89.G00.32.008": {"S89.G00.32.008", "Numéro, extension, nature et libellé de la voie"},
-	"S89.G00.32.009": {"S89.G00.32.009", "Code INSEE de la commune"},
-	"S89.G00.32.010": {"S89.G00.32.010", "Service de distribution, complément de localisation de la voie"},
-	"S89.G00.32.011": {"S89.G00.32.011", "Code postal"},
-	"S89.G00.32.012": {"S89.G00.32.012", "Localité"},
-	"S89.G00.32.013": {"S89.G00.32.013", "Code pays"},
-	"S89.G00.32.014": {"S89.G00.32.014", "Code de distribution à l'étranger"},
-	"S89.G00.32.015": {"S89.G00.32.015", "Code taux réduit ou dispense de retenue à la source"},
-	"S89.G00.32.016": {"S89.G00.32.016", "Montant TVA droits d'auteurs"},
-	"S89.G00.32.017": {"S89.G00.32.017", "Millésime de rattachement"},
-	"S89.G00.33.001": {"S89.G00.33.001", "Code type avantage en nature"},
-	"S89.G00.33.002": {"S89.G00.33.002", "Montant avantage en nature"},
-	"S89.G00.35.001": {"S89.G00.35.001", "Code modalité de prise en charge des indemnités"},
-	"S89.G00.35.002": {"S89.G00.35.002", "Montant de l'indemnité"},
-	"S89.G00.43.001": {"S89.G00.43.001", "Code type de la rémunération"},
-	"S89.G00.43.002": {"S89.G00.43.002", "Montant de la rémunération"},
-	"S89.G00.67.001": {"S89.G00.67.001", "NIR"},
-	"S89.G00.67.002": {"S89.G00.67.002", "Numéro technique temporaire"},
-	"S89.G00.67.003": {"S89.G00.67.003", "Montant de droit supplémentaire acquis "},
-	"S89.G00.67.004": {"S89.G00.67.004", "Pourcentage de droit supplémentaire acquis"},
-	"S89.G00.67.005": {"S89.G00.67.005", "Millésime de rattachement"},
-	"S89.G00.87.001": {"S89.G00.87.001", "Code contexte"},
-	"S89.G00.87.002": {"S89.G00.87.002", "Nombre d'actions"},
-	"S89.G00.87.003": {"S89.G00.87.003", "Valeur unitaire de l'action"},
-	"S89.G00.87.004": {"S89.G00.87.004", "Fraction du gain d'acquisition de source française"},
-	"S89.G00.87.005": {"S89.G00.87.005", "Date d'attribution"},
-	"S89.G00.87.006": {"S89.G00.87.006", "Date d'acquisition définitive"},
-	"S89.G00.87.007": {"S89.G00.87.007", "Numéro d'inscription au répertoire"},
-	"S89.G00.87.008": {"S89.G00.87.008", "Numéro technique temporaire"},
-	"S89.G00.88.001": {"S89.G00.88.001", "Code contexte"},
-	"S89.G00.88.002": {"S89.G00.88.002", "Nombre d'options"},
-	"S89.G00.88.003": {"S89.G00.88.003", "Valeur unitaire de l'action"},
-	"S89.G00.88.004": {"S89.G00.88.004", "Prix de souscription de l'action"},
-	"S89.G00.88.005": {"S89.G00.88.005", "Fraction du gain de levée d'option de source française"},
-	"S89.G00.88.006": {"S89.G00.88.006", "Date d'attribution"},
-	"S89.G00.88.007": {"S89.G00.88.007", "Date de levée de l'option"},
-	"S89.G00.88.008": {"S89.G00.88.008", "Numéro d'inscription au répertoire"},
-	"S89.G00.88.009": {"S89.G00.88.009", "Numéro technique temporaire"},
-	"S89.G00.89.001": {"S89.G00.89.001", "Nombre de titres"},
-	"S89.G00.89.002": {"S89.G00.89.002", "Prix d'acquisition des titres"},
-	"S89.G00.89.003": {"S89.G00.89.003", "Valeur unitaire des titres au jour de l'exercice des bons"},
-	"S89.G00.89.004": {"S89.G00.89.004", "Fraction du gain de source française"},
-	"S89.G00.89.005": {"S89.G00.89.005", "Date d'acquisition des titres"},
-	"S89.G00.89.006": {"S89.G00.89.006", "Durée d'exercice de l'activité du bénéficiaire dans l'entreprise"},
-	"S89.G00.89.007": {"S89.G00.89.007", "Numéro d'inscription au répertoire"},
-	"S89.G00.89.008": {"S89.G00.89.008", "Numéro technique temporaire"},
-	"S89.G00.91.001": {"S89.G00.91.001", "Numéro d'inscription au répertoire"},
-	"S89.G00.91.002": {"S89.G00.91.002", "Nom de famille"},
-	"S89.G00.91.003": {"S89.G00.91.003", "Nom d'usage"},
-	"S89.G00.91.004": {"S89.G00.91.004", "Prénoms"},
-	"S89.G00.91.005": {"S89.G00.91.005", "Sexe"},
-	"S89.G00.91.006": {"S89.G00.91.006", "Date de naissance"},
-	"S89.G00.91.007": {"S89.G00.91.007", "Lieu de naissance"},
-	"S89.G00.91.008": {"S89.G00.91.008", "Numéro, extension, nature et libellé de la voie"},
-	"S89.G00.91.009": {"S89.G00.91.009", "Code postal"},
-	"S89.G00.91.010": {"S89.G00.91.010", "Localité"},
-	"S89.G00.91.011": {"S89.G00.91.011", "Code pays"},
-	"S89.G00.91.012": {"S89.G00.91.012", "Code de distribution à l'étranger"},
-	"S89.G00.91.013": {"S89.G00.91.013", "Complément de la localisation de la construction"},
-	"S89.G00.91.014": {"S89.G00.91.014", "Service de distribution, complément de localisation de la voie"},
-	"S89.G00.91.015": {"S89.G00.91.015", "Adresse mél"},
-	"S89.G00.91.016": {"S89.G00.91.016", "Matricule de l'individu dans l'entreprise"},
-	"S89.G00.91.017": {"S89.G00.91.017", "Statut du salarié (conventionnel)"},
-	"S89.G00.91.018": {"S89.G00.91.018", "Code statut catégoriel Retraite Complémentaire obligatoire"},
-	"S89.G00.91.019": {"S89.G00.91.019", "Code département de naissance"},
-	"S89.G00.91.020": {"S89.G00.91.020", "Code pays de naissance"},
-	"S89.G00.91.021": {"S89.G00.91.021", "Numéro technique temporaire"},
-	"S89.G00.92.001": {"S89.G00.92.001", "Type"},
-	"S89.G00.92.002": {"S89.G00.92.002", "Code de base spécifique"},
-	"S89.G00.92.003": {"S89.G00.92.003", "Montant"},
-	"S89.G00.92.004": {"S89.G00.92.004", "Date de début de période de rattachement"},
-	"S89.G00.92.005": {"S89.G00.92.005", "Date de fin de période de rattachement"},
-	"S89.G00.92.006": {"S89.G00.92.006", "Montant net fiscal du revenu versé"},
-	"S89.G00.92.007": {"S89.G00.92.007", "Taux de prélèvement à la source"},
-	"S89.G00.92.008": {"S89.G00.92.008", "Type du taux de prélèvement à la source"},
-	"S89.G00.92.009": {"S89.G00.92.009", "Identifiant du taux de prélèvement à la source"},
-	"S89.G00.92.010": {"S89.G00.92.010", "Montant de prélèvement à la source"},
-	"S89.G00.92.011": {"S89.G00.92.011", "Date de versement"},
-	"S89.G00.92.012": {"S89.G00.92.012", "Montant de la part non imposable du revenu"},
-	"S89.G00.92.013": {"S89.G00.92.013", "Montant soumis au PAS "},
-	"S89.G00.92.014": {"S89.G00.92.014", "Montant de l’abattement sur la base fiscale (non déduit du montant net fiscal du revenu versé)"},
-	"S89.G00.92.015": {"S89.G00.92.015", "Identifiant du CRM à l'origine de la régularisation"},
-	"S89.G00.92.016": {"S89.G00.92.016", "Montant net versé"},
-	"S89.G00.92.017": {"S89.G00.92.017", "Montant net social"},
-	"S89.G00.93.001": {"S89.G00.93.001", "Mois de l'erreur"},
-	"S89.G00.93.002": {"S89.G00.93.002", "Type d'erreur"},
-	"S89.G00.93.003": {"S89.G00.93.003", "Régularisation du montant net fiscal du revenu versé"},
-	"S89.G00.93.004": {"S89.G00.93.004", "Montant net fiscal du revenu versé le mois de l’erreur"},
-	"S89.G00.93.005": {"S89.G00.93.005", "Régularisation du taux de prélèvement à la source"},
-	"S89.G00.93.006": {"S89.G00.93.006", "Taux déclaré le mois de l'erreur"},
-	"S89.G00.93.007": {"S89.G00.93.007", "Montant de la régularisation du prélèvement à la source"},
-	"S89.G00.93.008": {"S89.G00.93.008", "Régularisation du montant de la part non imposable du revenu"},
-	"S89.G00.93.009": {"S89.G00.93.009", "Régularisation du montant soumis au PAS "},
-	"S89.G00.93.010": {"S89.G00.93.010", "Régularisation du montant de l’abattement sur la base fiscale (non déduit du montant net fiscal du revenu versé)"},
-	"S89.G00.93.013": {"S89.G00.93.013", "Montant soumis au prélèvement à la source déclaré le mois de l'erreur"},
-	"S89.G00.94.001": {"S89.G00.94.001", "Code de cotisation"},
-	"S89.G00.94.002": {"S89.G00.94.002", "Montant de cotisation "},
-	"S90.G00.90.001": {"S90.G00.90.001", "Nombre total de rubriques"},
-	"S90.G00.90.002": {"S90.G00.90.002", "Nombre de DSN"},
+	"S10.G00.00.001": {"S10.G00.00.001", "Nom du logiciel utilisé", textRubric(1, 80)},
+	"S10.G00.00.002": {"S10.G00.00.002", "Nom de l'éditeur", textRubric(1, 80)},
+	"S10.G00.00.003": {"S10.G00.00.003", "Numéro de version du logiciel utilisé", textRubric(1, 40)},
+	"S10.G00.00.004": {"S10.G00.00.004", "Code de conformité en pré-contrôle", codeRubric(1, 8)},
+	"S10.G00.00.005": {"S10.G00.00.005", "Code envoi du fichier d'essai ou réel", codeRubric(1, 8)},
+	"S10.G00.00.006": {"S10.G00.00.006", "Numéro de version de la norme utilisée", textRubric(1, 40)},
+	"S10.G00.00.007": {"S10.G00.00.007", "Point de dépôt", codeRubric(1, 8)},
+	"S10.G00.00.008": {"S10.G00.00.008", "Type de l'envoi", codeRubric(1, 8)},
+	"S10.G00.01.001": {"S10.G00.01.001", "Siren de l'émetteur de l'envoi", numericRubric(9, 9)},
+	"S10.G00.01.002": {"S10.G00.01.002", "Nic de l'émetteur de l'envoi", numericRubric(5, 5)},
+	"S10.G00.01.003": {"S10.G00.01.003", "Nom ou raison sociale de l'émetteur", textRubric(1, 80)},
+	"S10.G00.01.004": {"S10.G00.01.004", "Numéro, extension, nature et libellé de la voie", textRubric(1, 50)},
+	"S10.G00.01.005": {"S10.G00.01.005", "Code postal", numericRubric(5, 5)},
+	"S10.G00.01.006": {"S10.G00.01.006", "Localité", textRubric(1, 50)},
+	"S10.G00.01.007": {"S10.G00.01.007", "Code pays", patternRubric(TypeCode, 2, 2, countryPattern)},
+	"S10.G00.01.008": {"S10.G00.01.008", "Code de distribution à l'étranger", textRubric(1, 50)},
+	"S10.G00.01.009": {"S10.G00.01.009", "Complément de la localisation de la construction", textRubric(1, 50)},
+	"S10.G00.01.010": {"S10.G00.01.010", "Service de distribution, complément de localisation de la voie", textRubric(1, 50)},
+	"S10.G00.02.001": {"S10.G00.02.001", "Code civilité", codeRubric(1, 8)},
+	"S10.G00.02.002": {"S10.G00.02.002", "Nom et prénom de la personne à contacter", textRubric(1, 80)},
+	"S10.G00.02.004": {"S10.G00.02.004", "Adresse mél du contact émetteur", patternRubric(TypeAlphanumeric, 3, 100, emailPattern)},
+	"S10.G00.02.005": {"S10.G00.02.005", "Adresse téléphonique", numericRubric(10, 20)},
+	"S10.G00.02.006": {"S10.G00.02.006", "Adresse fax", numericRubric(10, 20)},
+	"S20.G00.05.001": {"S20.G00.05.001", "Nature de la déclaration", codeRubric(1, 8)},
+	"S20.G00.05.002": {"S20.G00.05.002", "Type de la déclaration", codeRubric(1, 8)},
+	"S20.G00.05.003": {"S20.G00.05.003", "Numéro de fraction de déclaration", numericRubric(1, 6)},
+	"S20.G00.05.004": {"S20.G00.05.004", "Numéro d'ordre de la déclaration", numericRubric(1, 6)},
+	"S20.G00.05.005": {"S20.G00.05.005", "Date du mois principal déclaré", dateRubric()},
+	"S20.G00.05.006": {"S20.G00.05.006", "Identifiant de la déclaration annulée ou remplacée", textRubric(1, 40)},
+	"S20.G00.05.007": {"S20.G00.05.007", "Date de constitution du fichier", dateRubric()},
+	"S20.G00.05.008": {"S20.G00.05.008", "Champ de la déclaration", codeRubric(1, 8)},
+	"S20.G00.05.009": {"S20.G00.05.009", "Identifiant métier", textRubric(1, 40)},
+	"S20.G00.05.010": {"S20.G00.05.010", "Devise de la déclaration", codeRubric(1, 8)},
+	"S20.G00.05.011": {"S20.G00.05.011", "Nature de l’événement déclencheur du signalement ", codeRubric(1, 8)},
+	"S20.G00.05.012": {"S20.G00.05.012", "Dernier SIRET connu pour ancien numéro de contrat ", numericRubric(14, 14)},
+	"S20.G00.05.013": {"S20.G00.05.013", "Type de nature de DSN de substitution", codeRubric(1, 8)},
+	"S20.G00.07.001": {"S20.G00.07.001", "Nom et prénom du contact", textRubric(1, 80)},
+	"S20.G00.07.002": {"S20.G00.07.002", "Adresse téléphonique", numericRubric(10, 20)},
+	"S20.G00.07.003": {"S20.G00.07.003", "Adresse mél du contact", patternRubric(TypeAlphanumeric, 3, 100, emailPattern)},
+	"S20.G00.07.004": {"S20.G00.07.004", "Type", codeRubric(1, 8)},
+	"S20.G00.08.001": {"S20.G00.08.001", "Code caisse", codeRubric(1, 8)},
+	"S21.G00.06.001": {"S21.G00.06.001", "SIREN", numericRubric(9, 9)},
+	"S21.G00.06.002": {"S21.G00.06.002", "NIC du siège", numericRubric(5, 5)},
+	"S21.G00.06.003": {"S21.G00.06.003", "Code APEN", patternRubric(TypeCode, 5, 5, apePattern)},
+	"S21.G00.06.004": {"S21.G00.06.004", "Numéro, extension, nature et libellé de la voie", textRubric(1, 50)},
+	"S21.G00.06.005": {"S21.G00.06.005", "Code postal", numericRubric(5, 5)},
+	"S21.G00.06.006": {"S21.G00.06.006", "Localité", textRubric(1, 50)},
+	"S21.G00.06.007": {"S21.G00.06.007", "Complément de la localisation de la construction", textRubric(1, 50)},
+	"S21.G00.06.008": {"S21.G00.06.008", "Service de distribution, complément de localisation de la voie", textRubric(1, 50)},
+	"S21.G00.06.009": {"S21.G00.06.009", "Effectif moyen de l'entreprise au 31 décembre", numericRubric(1, 10)},
+	"S21.G00.06.010": {"S21.G00.06.010", "Code pays", patternRubric(TypeCode, 2, 2, countryPattern)},
+	"S21.G00.06.011": {"S21.G00.06.011", "Code de distribution à l'étranger", textRubric(1, 50)},
+	"S21.G00.06.012": {"S21.G00.06.012", "Implantation de l'entreprise", codeRubric(1, 8)},
+	"S21.G00.06.015": {"S21.G00.06.015", "Code convention collective applicable", codeRubric(1, 8)},
+	"S21.G00.11.001": {"S21.G00.11.001", "NIC", numericRubric(5, 5)},
+	"S21.G00.11.002": {"S21.G00.11.002", "Code APET", patternRubric(TypeCode, 5, 5, apePattern)},
+	"S21.G00.11.003": {"S21.G00.11.003", "Numéro, extension, nature et libellé de la voie", textRubric(1, 50)},
+	"S21.G00.11.004": {"S21.G00.11.004", "Code postal", numericRubric(5, 5)},
+	"S21.G00.11.005": {"S21.G00.11.005", "Localité", textRubric(1, 50)},
+	"S21.G00.11.006": {"S21.G00.11.006", "Complément de la localisation de la construction", textRubric(1, 50)},
+	"S21.G00.11.007": {"S21.G00.11.007", "Service de distribution, complément de localisation de la voie", textRubric(1, 50)},
+	"S21.G00.11.008": {"S21.G00.11.008", "Effectif de fin de période déclarée de l'établissement", numericRubric(1, 10)},
+	"S21.G00.11.009": {"S21.G00.11.009", "Type de rémunération soumise à contributions d'Assurance chômage pour expatriés", codeRubric(1, 8)},
+	"S21.G00.11.015": {"S21.G00.11.015", "Code pays", patternRubric(TypeCode, 2, 2, countryPattern)},
+	"S21.G00.11.016": {"S21.G00.11.016", "Code de distribution à l'étranger", textRubric(1, 50)},
+	"S21.G00.11.017": {"S21.G00.11.017", "Nature juridique de l'employeur", codeRubric(1, 8)},
+	"S21.G00.11.019": {"S21.G00.11.019", "Date d’effet de l’adhésion au dispositif TESE/CEA ", dateRubric()},
+	"S21.G00.11.020": {"S21.G00.11.020", "Date d’effet de la sortie du dispositif TESE/CEA", dateRubric()},
+	"S21.G00.11.022": {"S21.G00.11.022", "Code convention collective principale", codeRubric(1, 8)},
+	"S21.G00.11.023": {"S21.G00.11.023", "Opérateur de compétences (OPCO)", codeRubric(1, 8)},
+	"S21.G00.11.024": {"S21.G00.11.024", "Demande de sortie de la DSN", codeRubric(1, 8)},
+	"S21.G00.12.001": {"S21.G00.12.001", "Type d’usage", codeRubric(1, 8)},
+	"S21.G00.12.002": {"S21.G00.12.002", "BIC", patternRubric(TypeAlphanumeric, 8, 11, bicPattern)},
+	"S21.G00.12.003": {"S21.G00.12.003", "IBAN", patternRubric(TypeAlphanumeric, 15, 34, ibanPattern)},
+	"S21.G00.13.001": {"S21.G00.13.001", "Accord agréé OETH", codeRubric(2, 2)},
+	"S21.G00.13.002": {"S21.G00.13.002", "Type BOETH externe", codeRubric(1, 8)},
+	"S21.G00.13.003": {"S21.G00.13.003", "Nombre BOETH externe", numericRubric(1, 10)},
+	"S21.G00.13.004": {"S21.G00.13.004", "Millésime de rattachement", numericRubric(4, 4)},
+	"S21.G00.15.001": {"S21.G00.15.001", "Référence du contrat de Prévoyance", textRubric(1, 40)},
+	"S21.G00.15.002": {"S21.G00.15.002", "Code organisme de Prévoyance", textRubric(1, 40)},
+	"S21.G00.15.003": {"S21.G00.15.003", "Code délégataire de gestion", textRubric(1, 40)},
+	"S21.G00.15.004": {"S21.G00.15.004", "Personnel couvert", textRubric(1, 80)},
+	"S21.G00.15.005": {"S21.G00.15.005", "Identifiant technique Adhésion", textRubric(1, 40)},
+	"S21.G00.16.001": {"S21.G00.16.001", "Date de la modification", dateRubric()},
+	"S21.G00.16.002": {"S21.G00.16.002", "Ancien Code organisme de Prévoyance", textRubric(1, 40)},
+	"S21.G00.16.003": {"S21.G00.16.003", "Ancien Code délégataire de gestion", textRubric(1, 40)},
+	"S21.G00.20.001": {"S21.G00.20.001", "Identifiant Organisme de Protection Sociale", textRubric(1, 40)},
+	"S21.G00.20.002": {"S21.G00.20.002", "Entité d'affectation des opérations", codeRubric(1, 8)},
+	"S21.G00.20.003": {"S21.G00.20.003", "BIC", patternRubric(TypeAlphanumeric, 8, 11, bicPattern)},
+	"S21.G00.20.004": {"S21.G00.20.004", "IBAN", patternRubric(TypeAlphanumeric, 15, 34, ibanPattern)},
+	"S21.G00.20.005": {"S21.G00.20.005", "Montant du versement", decimalRubric(FormatAmount)},
+	"S21.G00.20.006": {"S21.G00.20.006", "Date de début de période de rattachement", dateRubric()},
+	"S21.G00.20.007": {"S21.G00.20.007", "Date de fin de période de rattachement", dateRubric()},
+	"S21.G00.20.008": {"S21.G00.20.008", "Code délégataire de gestion", textRubric(1, 40)},
+	"S21.G00.20.010": {"S21.G00.20.010", "Mode de paiement", codeRubric(1, 8)},
+	"S21.G00.20.011": {"S21.G00.20.011", "Date de paiement", dateRubric()},
+	"S21.G00.20.012": {"S21.G00.20.012", "SIRET Payeur", numericRubric(14, 14)},
+	"S21.G00.20.013": {"S21.G00.20.013", "Identifiant du CRM à l'origine de la régularisation", textRubric(1, 40)},
+	"S21.G00.20.014": {"S21.G00.20.014", "Identifiant du versement", textRubric(1, 40)},
+	"S21.G00.22.001": {"S21.G00.22.001", "Identifiant Organisme de Protection Sociale", textRubric(1, 40)},
+	"S21.G00.22.002": {"S21.G00.22.002", "Entité d'affectation des opérations", codeRubric(1, 8)},
+	"S21.G00.22.003": {"S21.G00.22.003", "Date de début de période de rattachement", dateRubric()},
+	"S21.G00.22.004": {"S21.G00.22.004", "Date de fin de période de rattachement", dateRubric()},
+	"S21.G00.22.005": {"S21.G00.22.005", "Montant total de cotisations", decimalRubric(FormatAmount)},
+	"S21.G00.22.006": {"S21.G00.22.006", "Identifiant du CRM à l'origine de la régularisation", textRubric(1, 40)},
+	"S21.G00.23.001": {"S21.G00.23.001", "Code de cotisation", codeRubric(1, 8)},
+	"S21.G00.23.002": {"S21.G00.23.002", "Qualifiant d'assiette", codeRubric(1, 8)},
+	"S21.G00.23.003": {"S21.G00.23.003", "Taux de cotisation", decimalRubric(FormatRate)},
+	"S21.G00.23.004": {"S21.G00.23.004", "Montant d'assiette", decimalRubric(FormatAmount)},
+	"S21.G00.23.005": {"S21.G00.23.005", "Montant de cotisation", decimalRubric(FormatAmount)},
+	"S21.G00.23.006": {"S21.G00.23.006", "Code INSEE commune", patternRubric(TypeCode, 5, 5, inseePattern)},
+	"S21.G00.23.007": {"S21.G00.23.007", "Identifiant du CRM à l'origine de la régularisation", textRubric(1, 40)},
+	"S21.G00.30.001": {"S21.G00.30.001", "Numéro d'inscription au répertoire", patternRubric(TypeAlphanumeric, 13, 15, nirPattern)},
+	"S21.G00.30.002": {"S21.G00.30.002", "Nom de famille", textRubric(1, 80)},
+	"S21.G00.30.003": {"S21.G00.30.003", "Nom d'usage", textRubric(1, 80)},
+	"S21.G00.30.004": {"S21.G00.30.004", "Prénoms", textRubric(1, 80)},
+	"S21.G00.30.005": {"S21.G00.30.005", "Sexe", codeRubric(2, 2)},
+	"S21.G00.30.006": {"S21.G00.30.006", "Date de naissance", dateRubric()},
+	"S21.G00.30.007": {"S21.G00.30.007", "Lieu de naissance", textRubric(1, 50)},
+	"S21.G00.30.008": {"S21.G00.30.008", "Numéro, extension, nature et libellé de la voie", textRubric(1, 50)},
+	"S21.G00.30.009": {"S21.G00.30.009", "Code postal", numericRubric(5, 5)},
+	"S21.G00.30.010": {"S21.G00.30.010", "Localité", textRubric(1, 50)},
+	"S21.G00.30.011": {"S21.G00.30.011", "Code pays", patternRubric(TypeCode, 2, 2, countryPattern)},
+	"S21.G00.30.012": {"S21.G00.30.012", "Code de distribution à l'étranger", textRubric(1, 50)},
+	"S21.G00.30.013": {"S21.G00.30.013", "Codification UE", codeRubric(2, 2, "01", "02", "03")},
+	"S21.G00.30.014": {"S21.G00.30.014", "Code département de naissance", patternRubric(TypeCode, 2, 3, departmentPattern)},
+	"S21.G00.30.015": {"S21.G00.30.015", "Code pays de naissance", patternRubric(TypeCode, 2, 2, countryPattern)},
+	"S21.G00.30.016": {"S21.G00.30.016", "Complément de la localisation de la construction", textRubric(1, 50)},
+	"S21.G00.30.017": {"S21.G00.30.017", "Service de distribution, complément de localisation de la voie", textRubric(1, 50)},
+	"S21.G00.30.018": {"S21.G00.30.018", "Adresse mél", patternRubric(TypeAlphanumeric, 3, 100, emailPattern)},
+	"S21.G00.30.019": {"S21.G00.30.019", "Matricule de l'individu dans l'entreprise", textRubric(1, 40)},
+	"S21.G00.30.020": {"S21.G00.30.020", "Numéro technique temporaire", textRubric(1, 40)},
+	"S21.G00.30.022": {"S21.G00.30.022", "Statut à l'étranger au sens fiscal", codeRubric(1, 8)},
+	"S21.G00.30.023": {"S21.G00.30.023", "Cumul emploi retraite", codeRubric(1, 8)},
+	"S21.G00.30.024": {"S21.G00.30.024", "Niveau de formation le plus élevé obtenu par l'individu", codeRubric(1, 8)},
+	"S21.G00.30.025": {"S21.G00.30.025", "Niveau de diplôme préparé par l'individu", codeRubric(1, 8)},
+	"S21.G00.30.029": {"S21.G00.30.029", "Libellé du pays de naissance", textRubric(1, 120)},
+	"S21.G00.31.001": {"S21.G00.31.001", "Date de la modification", dateRubric()},
+	"S21.G00.31.008": {"S21.G00.31.008", "Ancien NIR", patternRubric(TypeAlphanumeric, 13, 15, nirPattern)},
+	"S21.G00.31.009": {"S21.G00.31.009", "Ancien nom de famille", textRubric(1, 80)},
+	"S21.G00.31.010": {"S21.G00.31.010", "Anciens prénoms", textRubric(1, 80)},
+	"S21.G00.31.011": {"S21.G00.31.011", "Ancienne date de naissance", dateRubric()},
+	"S21.G00.34.001": {"S21.G00.34.001", "Facteur d'exposition", codeRubric(1, 8)},
+	"S21.G00.34.002": {"S21.G00.34.002", "Numéro du contrat", textRubric(1, 40)},
+	"S21.G00.34.003": {"S21.G00.34.003", "Année de rattachement", numericRubric(4, 4)},
+	"S21.G00.40.001": {"S21.G00.40.001", "Date de début du contrat", dateRubric()},
+	"S21.G00.40.002": {"S21.G00.40.002", "Statut du salarié (conventionnel)", codeRubric(1, 8)},
+	"S21.G00.40.003": {"S21.G00.40.003", "Code statut catégoriel Retraite Complémentaire obligatoire", codeRubric(1, 8)},
+	"S21.G00.40.004": {"S21.G00.40.004", "Code profession et catégorie socioprofessionnelle (PCS-ESE)", codeRubric(1, 8)},
+	"S21.G00.40.005": {"S21.G00.40.005", "Code complément PCS-ESE", codeRubric(1, 8)},
+	"S21.G00.40.006": {"S21.G00.40.006", "Libellé de l'emploi", textRubric(1, 120)},
+	"S21.G00.40.007": {"S21.G00.40.007", "Nature du contrat", codeRubric(1, 8)},
+	"S21.G00.40.008": {"S21.G00.40.008", "Dispositif de politique publique et conventionnel", codeRubric(1, 8)},
+	"S21.G00.40.009": {"S21.G00.40.009", "Numéro du contrat", textRubric(1, 40)},
+	"S21.G00.40.010": {"S21.G00.40.010", "Date de fin prévisionnelle du contrat", dateRubric()},
+	"S21.G00.40.011": {"S21.G00.40.011", "Unité de mesure de la quotité de travail", codeRubric(1, 8)},
+	"S21.G00.40.012": {"S21.G00.40.012", "Quotité de travail de référence de l'entreprise pour la catégorie de salarié", decimalRubric(FormatQuotity)},
+	"S21.G00.40.013": {"S21.G00.40.013", "Quotité de travail du contrat", decimalRubric(FormatQuotity)},
+	"S21.G00.40.014": {"S21.G00.40.014", "Modalité d'exercice du temps de travail", codeRubric(1, 8)},
+	"S21.G00.40.016": {"S21.G00.40.016", "Complément de base au régime obligatoire", codeRubric(1, 8)},
+	"S21.G00.40.017": {"S21.G00.40.017", "Code convention collective applicable", codeRubric(1, 8)},
+	"S21.G00.40.018": {"S21.G00.40.018", "Code régime de base risque maladie", codeRubric(1, 8)},
+	"S21.G00.40.019": {"S21.G00.40.019", "Identifiant du lieu de travail", numericRubric(14, 14)},
+	"S21.G00.40.020": {"S21.G00.40.020", "Code régime de base risque vieillesse", codeRubric(1, 8)},
+	"S21.G00.40.021": {"S21.G00.40.021", "Motif de recours", codeRubric(1, 8)},
+	"S21.G00.40.022": {"S21.G00.40.022", "Code caisse professionnelle de congés payés", codeRubric(1, 8)},
+	"S21.G00.40.023": {"S21.G00.40.023", "Taux de déduction forfaitaire spécifique pour frais professionnels", decimalRubric(FormatRate)},
+	"S21.G00.40.024": {"S21.G00.40.024", "Travailleur à l'étranger au sens du code de la Sécurité Sociale", codeRubric(1, 8)},
+	"S21.G00.40.025": {"S21.G00.40.025", "Motif d'exclusion DSN", codeRubric(1, 8)},
+	"S21.G00.40.026": {"S21.G00.40.026", "Statut d'emploi du salarié", codeRubric(1, 8)},
+	"S21.G00.40.027": {"S21.G00.40.027", "Code affectation Assurance chômage", codeRubric(1, 8)},
+	"S21.G00.40.028": {"S21.G00.40.028", "Numéro interne employeur public", textRubric(1, 40)},
+	"S21.G00.40.029": {"S21.G00.40.029", "Type de gestion de l'Assurance chômage", codeRubric(1, 8)},
+	"S21.G00.40.030": {"S21.G00.40.030", "Date d'adhésion", dateRubric()},
+	"S21.G00.40.031": {"S21.G00.40.031", "Date de dénonciation", dateRubric()},
+	"S21.G00.40.032": {"S21.G00.40.032", "Date d'effet de la convention de gestion", dateRubric()},
+	"S21.G00.40.033": {"S21.G00.40.033", "Numéro de convention de gestion", textRubric(1, 40)},
+	"S21.G00.40.035": {"S21.G00.40.035", "Code délégataire du risque maladie", textRubric(1, 40)},
+	"S21.G00.40.036": {"S21.G00.40.036", "Code emplois multiples", codeRubric(1, 8)},
+	"S21.G00.40.037": {"S21.G00.40.037", "Code employeurs multiples", codeRubric(1, 8)},
+	"S21.G00.40.039": {"S21.G00.40.039", "Code régime de base risque accident du travail", codeRubric(1, 8)},
+	"S21.G00.40.040": {"S21.G00.40.040", "Code risque accident du travail", codeRubric(1, 8)},
+	"S21.G00.40.041": {"S21.G00.40.041", "Positionnement dans la convention collective", codeRubric(1, 8)},
+	"S21.G00.40.042": {"S21.G00.40.042", "Code statut catégoriel APECITA", codeRubric(1, 8)},
+	"S21.G00.40.043": {"S21.G00.40.043", "Taux de cotisation accident du travail", decimalRubric(FormatRate)},
+	"S21.G00.40.044": {"S21.G00.40.044", "Salarié à temps partiel cotisant à temps plein", codeRubric(2, 2)},
+	"S21.G00.40.045": {"S21.G00.40.045", "Rémunération au pourboire", codeRubric(2, 2)},
+	"S21.G00.40.046": {"S21.G00.40.046", "Identifiant de l'établissement utilisateur", numericRubric(14, 14)},
+	"S21.G00.40.048": {"S21.G00.40.048", "Numéro de label « Prestataire de services du spectacle vivant »", textRubric(1, 40)},
+	"S21.G00.40.049": {"S21.G00.40.049", "Numéro de licence entrepreneur spectacle", textRubric(1, 40)},
+	"S21.G00.40.050": {"S21.G00.40.050", "Numéro objet spectacle", textRubric(1, 40)},
+	"S21.G00.40.051": {"S21.G00.40.051", "Statut organisateur spectacle", codeRubric(1, 8)},
+	"S21.G00.40.052": {"S21.G00.40.052", "[FP] Code complément PCS-ESE pour la fonction publique d'Etat (NNE)", codeRubric(1, 8)},
+	"S21.G00.40.053": {"S21.G00.40.053", "Nature du poste", codeRubric(1, 8)},
+	"S21.G00.40.054": {"S21.G00.40.054", "[FP] Quotité de travail de référence de l'entreprise pour la catégorie de salarié dans l’hypothèse d’un poste à temps complet", decimalRubric(FormatQuotity)},
+	"S21.G00.40.055": {"S21.G00.40.055", "Taux de travail à temps partiel", decimalRubric(FormatRate)},
+	"S21.G00.40.056": {"S21.G00.40.056", "Code catégorie de service", codeRubric(1, 8)},
+	"S21.G00.40.057": {"S21.G00.40.057", "[FP] Indice brut", numericRubric(1, 10)},
+	"S21.G00.40.058": {"S21.G00.40.058", "[FP] Indice majoré", numericRubric(1, 10)},
+	"S21.G00.40.059": {"S21.G00.40.059", "[FP] Nouvelle bonification indiciaire (NBI)", numericRubric(1, 10)},
+	"S21.G00.40.060": {"S21.G00.40.060", "[FP] Indice brut d'origine", numericRubric(1, 10)},
+	"S21.G00.40.061": {"S21.G00.40.061", "[FP] Indice brut de cotisation dans un emploi supérieur (article 15)", numericRubric(1, 10)},
+	"S21.G00.40.062": {"S21.G00.40.062", "[FP] Ancien employeur public ", codeRubric(1, 8)},
+	"S21.G00.40.063": {"S21.G00.40.063", "[FP] Indice brut d’origine ancien salarié employeur public", numericRubric(1, 10)},
+	"S21.G00.40.064": {"S21.G00.40.064", "[FP] Indice brut d’origine sapeur-pompier professionnel (SPP)", numericRubric(1, 10)},
+	"S21.G00.40.065": {"S21.G00.40.065", "[FP] Maintien du traitement d'origine d'un contractuel titulaire", codeRubric(1, 8)},
+	"S21.G00.40.066": {"S21.G00.40.066", "[FP] Type de détachement", codeRubric(1, 8)},
+	"S21.G00.40.067": {"S21.G00.40.067", "Genre de navigation", codeRubric(1, 8)},
+	"S21.G00.40.068": {"S21.G00.40.068", "Taux de service actif ", decimalRubric(FormatRate)},
+	"S21.G00.40.069": {"S21.G00.40.069", "Niveau de rémunération", codeRubric(1, 8)},
+	"S21.G00.40.070": {"S21.G00.40.070", "Echelon", codeRubric(1, 8)},
+	"S21.G00.40.071": {"S21.G00.40.071", "Coefficient hiérarchique ", numericRubric(1, 6)},
+	"S21.G00.40.072": {"S21.G00.40.072", "Statut BOETH", codeRubric(1, 8)},
+	"S21.G00.40.073": {"S21.G00.40.073", "Complément de dispositif de politique publique", codeRubric(1, 8)},
+	"S21.G00.40.074": {"S21.G00.40.074", "Cas de mise à disposition externe d'un individu de l'établissement", codeRubric(1, 8)},
+	"S21.G00.40.075": {"S21.G00.40.075", "Catégorie de classement finale ", codeRubric(1, 8)},
+	"S21.G00.40.076": {"S21.G00.40.076", "Identifiant du contrat d'engagement maritime", textRubric(1, 40)},
+	"S21.G00.40.077": {"S21.G00.40.077", "Collège (CNIEG)", codeRubric(1, 8)},
+	"S21.G00.40.078": {"S21.G00.40.078", "Forme d'aménagement du temps de travail dans le cadre de l'activité partielle", codeRubric(1, 8)},
+	"S21.G00.40.079": {"S21.G00.40.079", "Grade", codeRubric(1, 8)},
+	"S21.G00.40.080": {"S21.G00.40.080", "[FP] Indice complément de traitement indiciaire (CTI)", numericRubric(1, 10)},
+	"S21.G00.40.081": {"S21.G00.40.081", "FINESS géographique", numericRubric(9, 9)},
+	"S21.G00.41.001": {"S21.G00.41.001", "Date de la modification", dateRubric()},
+	"S21.G00.41.002": {"S21.G00.41.002", "Ancien statut du salarié (conventionnel)", codeRubric(1, 8)},
+	"S21.G00.41.003": {"S21.G00.41.003", "Ancien code statut catégoriel Retraite Complémentaire obligatoire", codeRubric(1, 8)},
+	"S21.G00.41.004": {"S21.G00.41.004", "Ancienne nature du contrat", codeRubric(1, 8)},
+	"S21.G00.41.005": {"S21.G00.41.005", "Ancien dispositif de politique publique et conventionnel", codeRubric(1, 8)},
+	"S21.G00.41.006": {"S21.G00.41.006", "Ancienne unité de mesure de la quotité de travail", codeRubric(1, 8)},
+	"S21.G00.41.007": {"S21.G00.41.007", "Ancienne quotité de travail du contrat", decimalRubric(FormatQuotity)},
+	"S21.G00.41.008": {"S21.G00.41.008", "Ancienne modalité d'exercice du temps de travail", codeRubric(1, 8)},
+	"S21.G00.41.010": {"S21.G00.41.010", "Ancien complément de base au régime obligatoire", codeRubric(1, 8)},
+	"S21.G00.41.011": {"S21.G00.41.011", "Ancien code convention collective applicable", codeRubric(1, 8)},
+	"S21.G00.41.012": {"S21.G00.41.012", "SIRET ancien établissement d'affectation", numericRubric(14, 14)},
+	"S21.G00.41.013": {"S21.G00.41.013", "Ancien identifiant du lieu de travail", numericRubric(14, 14)},
+	"S21.G00.41.014": {"S21.G00.41.014", "Ancien numéro du contrat", textRubric(1, 40)},
+	"S21.G00.41.016": {"S21.G00.41.016", "Ancien motif de recours", codeRubric(1, 8)},
+	"S21.G00.41.017": {"S21.G00.41.017", "Ancien taux de déduction forfaitaire spécifique pour frais professionnels", decimalRubric(FormatRate)},
+	"S21.G00.41.018": {"S21.G00.41.018", "Ancien travailleur à l'étranger au sens du code de la Sécurité Sociale", codeRubric(1, 8)},
+	"S21.G00.41.019": {"S21.G00.41.019", "Ancien code profession et catégorie socioprofessionnelle (PCS-ESE)", codeRubric(1, 8)},
+	"S21.G00.41.020": {"S21.G00.41.020", "Ancien code complément PCS-ESE", codeRubric(1, 8)},
+	"S21.G00.41.021": {"S21.G00.41.021", "Ancienne date de début du contrat", dateRubric()},
+	"S21.G00.41.022": {"S21.G00.41.022", "Ancienne quotité de travail de référence de l'entreprise pour la catégorie de salarié", decimalRubric(FormatQuotity)},
+	"S21.G00.41.023": {"S21.G00.41.023", "Ancien code caisse professionnelle de congés payés", codeRubric(1, 8)},
+	"S21.G00.41.024": {"S21.G00.41.024", "Ancien code risque accident du travail", codeRubric(1, 8)},
+	"S21.G00.41.025": {"S21.G00.41.025", "Ancien code statut catégoriel APECITA", codeRubric(1, 8)},
+	"S21.G00.41.027": {"S21.G00.41.027", "Ancien salarié à temps partiel cotisant à temps plein", codeRubric(1, 8)},
+	"S21.G00.41.028": {"S21.G00.41.028", "Profondeur de recalcul de la paie ", numericRubric(1, 6)},
+	"S21.G00.41.029": {"S21.G00.41.029", "[FP] Ancien code complément PCS-ESE pour la fonction publique d'Etat (NNE)", codeRubric(1, 8)},
+	"S21.G00.41.030": {"S21.G00.41.030", "Ancienne nature du poste", codeRubric(1, 8)},
+	"S21.G00.41.031": {"S21.G00.41.031", "[FP] Ancienne quotité de travail de référence de l'entreprise pour la catégorie de salarié dans l’hypothèse d’un poste à temps complet", decimalRubric(FormatQuotity)},
+	"S21.G00.41.032": {"S21.G00.41.032", "Ancien taux de travail à temps partiel", decimalRubric(FormatRate)},
+	"S21.G00.41.033": {"S21.G00.41.033", "Ancien code catégorie de service", codeRubric(1, 8)},
+	"S21.G00.41.034": {"S21.G00.41.034", "[FP] Ancien indice brut", numericRubric(1, 6)},
+	"S21.G00.41.035": {"S21.G00.41.035", "[FP] Ancien indice majoré", numericRubric(1, 6)},
+	"S21.G00.41.036": {"S21.G00.41.036", "[FP] Ancienne nouvelle bonification indiciaire (NBI)", numericRubric(1, 6)},
+	"S21.G00.41.037": {"S21.G00.41.037", "[FP] Ancien indice brut d'origine", numericRubric(1, 6)},
+	"S21.G00.41.038": {"S21.G00.41.038", "[FP] Ancien indice brut de cotisation dans un emploi supérieur (article 15)", numericRubric(1, 6)},
+	"S21.G00.41.039": {"S21.G00.41.039", "[FP] Ancien ancien employeur public", codeRubric(1, 8)},
+	"S21.G00.41.040": {"S21.G00.41.040", "[FP] Ancien indice brut d’origine ancien salarié employeur public", numericRubric(1, 6)},
+	"S21.G00.41.041": {"S21.G00.41.041", "[FP] Ancien indice brut d’origine sapeur-pompier professionnel (SPP)", numericRubric(1, 6)},
+	"S21.G00.41.042": {"S21.G00.41.042", "[FP] Ancien maintien du traitement d'origine d'un contractuel titulaire", codeRubric(1, 8)},
+	"S21.G00.41.043": {"S21.G00.41.043", "Ancien taux de service actif", decimalRubric(FormatRate)},
+	"S21.G00.41.044": {"S21.G00.41.044", "Ancien niveau de rémunération", codeRubric(1, 8)},
+	"S21.G00.41.045": {"S21.G00.41.045", "Ancien échelon", codeRubric(1, 8)},
+	"S21.G00.41.046": {"S21.G00.41.046", "Ancien coefficient hiérarchique ", numericRubric(1, 6)},
+	"S21.G00.41.047": {"S21.G00.41.047", "Ancien genre de navigation", codeRubric(1, 8)},
+	"S21.G00.41.048": {"S21.G00.41.048", "Ancien statut BOETH", codeRubric(1, 8)},
+	"S21.G00.41.049": {"S21.G00.41.049", "Ancien complément de dispositif de politique publique", codeRubric(1, 8)},
+	"S21.G00.41.050": {"S21.G00.41.050", "Ancien cas de mise à disposition externe d'un individu de l'établissement", codeRubric(1, 8)},
+	"S21.G00.41.051": {"S21.G00.41.051", "Ancienne catégorie de classement finale", codeRubric(1, 8)},
+	"S21.G00.41.052": {"S21.G00.41.052", "Ancien code régime de base risque maladie", codeRubric(1, 8)},
+	"S21.G00.41.053": {"S21.G00.41.053", "Ancien code régime de base risque vieillesse", codeRubric(1, 8)},
+	"S21.G00.41.054": {"S21.G00.41.054", "Ancien identifiant du contrat d'engagement maritime", textRubric(1, 40)},
+	"S21.G00.41.055": {"S21.G00.41.055", "Ancien collège (CNIEG) ", codeRubric(1, 8)},
+	"S21.G00.41.056": {"S21.G00.41.056", "Ancienne forme d'aménagement du temps de travail dans le cadre de l'activité partielle", codeRubric(1, 8)},
+	"S21.G00.41.057": {"S21.G00.41.057", "[FP] Ancien type de détachement", codeRubric(1, 8)},
+	"S21.G00.41.058": {"S21.G00.41.058", "Ancien positionnement dans la convention collective", codeRubric(1, 8)},
+	"S21.G00.41.059": {"S21.G00.41.059", "Ancien code régime de base risque accident du travail", codeRubric(1, 8)},
+	"S21.G00.41.060": {"S21.G00.41.060", "Ancien statut d'emploi du salarié", codeRubric(1, 8)},
+	"S21.G00.41.061": {"S21.G00.41.061", "Ancien code emplois multiples", codeRubric(1, 8)},
+	"S21.G00.41.062": {"S21.G00.41.062", "Ancien code employeurs multiples", codeRubric(1, 8)},
+	"S21.G00.41.063": {"S21.G00.41.063", "Ancien grade", codeRubric(1, 8)},
+	"S21.G00.41.064": {"S21.G00.41.064", "[FP] Ancien indice complément de traitement indiciaire (CTI)", numericRubric(1, 6)},
+	"S21.G00.41.065": {"S21.G00.41.065", "Ancien FINESS géographique", numericRubric(9, 9)},
+	"S21.G00.44.001": {"S21.G00.44.001", "Code taxe", codeRubric(1, 8)},
+	"S21.G00.44.002": {"S21.G00.44.002", "Montant", decimalRubric(FormatAmount)},
+	"S21.G00.44.003": {"S21.G00.44.003", "Millésime de rattachement", numericRubric(4, 4)},
+	"S21.G00.44.004": {"S21.G00.44.004", "Motif de non assujettissement à la taxe d'apprentissage", codeRubric(1, 8)},
+	"S21.G00.45.001": {"S21.G00.45.001", "SIRET déclarant le contrat précédemment", numericRubric(14, 14)},
+	"S21.G00.45.002": {"S21.G00.45.002", "Numéro du contrat déclaré précédemment", textRubric(1, 40)},
+	"S21.G00.50.001": {"S21.G00.50.001", "Date de versement", dateRubric()},
+	"S21.G00.50.002": {"S21.G00.50.002", "Rémunération nette fiscale", decimalRubric(FormatAmount)},
+	"S21.G00.50.003": {"S21.G00.50.003", "Numéro de versement", textRubric(1, 40)},
+	"S21.G00.50.004": {"S21.G00.50.004", "Montant net versé", decimalRubric(FormatAmount)},
+	"S21.G00.50.006": {"S21.G00.50.006", "Taux de prélèvement à la source", decimalRubric(FormatRate)},
+	"S21.G00.50.007": {"S21.G00.50.007", "Type du taux de prélèvement à la source", codeRubric(1, 8)},
+	"S21.G00.50.008": {"S21.G00.50.008", "Identifiant du taux de prélèvement à la source", textRubric(1, 40)},
+	"S21.G00.50.009": {"S21.G00.50.009", "Montant de prélèvement à la source", decimalRubric(FormatAmount)},
+	"S21.G00.50.011": {"S21.G00.50.011", "Montant de la part non imposable du revenu", decimalRubric(FormatAmount)},
+	"S21.G00.50.012": {"S21.G00.50.012", "Montant de l’abattement sur la base fiscale (non déduit de la rémunération nette fiscale)", decimalRubric(FormatAmount)},
+	"S21.G00.50.013": {"S21.G00.50.013", "Montant soumis au PAS ", decimalRubric(FormatAmount)},
+	"S21.G00.50.020": {"S21.G00.50.020", "Mois de la DSN mensuelle de rattachement des éléments déclarés dans le FCTU", patternRubric(TypeDate, 7, 7, monthPattern)},
+	"S21.G00.51.001": {"S21.G00.51.001", "Date de début de période de paie", dateRubric()},
+	"S21.G00.51.002": {"S21.G00.51.002", "Date de fin de période de paie", dateRubric()},
+	"S21.G00.51.010": {"S21.G00.51.010", "Numéro du contrat", textRubric(1, 40)},
+	"S21.G00.51.011": {"S21.G00.51.011", "Type", codeRubric(1, 8)},
+	"S21.G00.51.012": {"S21.G00.51.012", "Nombre d'heures", numericRubric(1, 10)},
+	"S21.G00.51.013": {"S21.G00.51.013", "Montant", decimalRubric(FormatAmount)},
+	"S21.G00.51.014": {"S21.G00.51.014", "[FP] Taux de rémunération de la situation administrative", decimalRubric(FormatRate)},
+	"S21.G00.51.015": {"S21.G00.51.015", "Taux de conduite centrale nucléaire ", decimalRubric(FormatRate)},
+	"S21.G00.51.016": {"S21.G00.51.016", "Taux de majoration", decimalRubric(FormatRate)},
+	"S21.G00.51.019": {"S21.G00.51.019", "Taux de rémunération cotisée", decimalRubric(FormatRate)},
+	"S21.G00.51.020": {"S21.G00.51.020", "Taux de majoration ex-apprenti/ex-élève", decimalRubric(FormatRate)},
+	"S21.G00.52.001": {"S21.G00.52.001", "Type", codeRubric(1, 8)},
+	"S21.G00.52.002": {"S21.G00.52.002", "Montant", decimalRubric(FormatAmount)},
+	"S21.G00.52.003": {"S21.G00.52.003", "Date de début de la période de rattachement", dateRubric()},
+	"S21.G00.52.004": {"S21.G00.52.004", "Date de fin de la période de rattachement", dateRubric()},
+	"S21.G00.52.006": {"S21.G00.52.006", "Numéro du contrat", textRubric(1, 40)},
+	"S21.G00.52.007": {"S21.G00.52.007", "Date de versement d'origine", dateRubric()},
+	"S21.G00.53.001": {"S21.G00.53.001", "Type", codeRubric(1, 8)},
+	"S21.G00.53.002": {"S21.G00.53.002", "Mesure", decimalRubric(FormatQuotity)},
+	"S21.G00.53.003": {"S21.G00.53.003", "Unité de mesure", codeRubric(1, 8)},
+	"S21.G00.54.001": {"S21.G00.54.001", "Type", codeRubric(1, 8)},
+	"S21.G00.54.002": {"S21.G00.54.002", "Montant", decimalRubric(FormatAmount)},
+	"S21.G00.54.003": {"S21.G00.54.003", "Date de début de période de rattachement", dateRubric()},
+	"S21.G00.54.004": {"S21.G00.54.004", "Date de fin de période de rattachement", dateRubric()},
+	"S21.G00.55.001": {"S21.G00.55.001", "Montant versé", decimalRubric(FormatAmount)},
+	"S21.G00.55.002": {"S21.G00.55.002", "Type de population", codeRubric(1, 8)},
+	"S21.G00.55.003": {"S21.G00.55.003", "Code d'affectation", codeRubric(1, 8)},
+	"S21.G00.55.004": {"S21.G00.55.004", "Période d'affectation", codeRubric(1, 8)},
+	"S21.G00.55.005": {"S21.G00.55.005", "Identifiant du CRM à l'origine de la régularisation", textRubric(1, 40)},
+	"S21.G00.56.001": {"S21.G00.56.001", "Mois de l'erreur ", dateRubric()},
+	"S21.G00.56.002": {"S21.G00.56.002", "Type d'erreur", codeRubric(1, 8)},
+	"S21.G00.56.003": {"S21.G00.56.003", "Régularisation de la rémunération nette fiscale", decimalRubric(FormatAmount)},
+	"S21.G00.56.004": {"S21.G00.56.004", "Rémunération nette fiscale déclarée le mois de l’erreur", decimalRubric(FormatAmount)},
+	"S21.G00.56.005": {"S21.G00.56.005", "Régularisation du taux de prélèvement à la source ", codeRubric(1, 8)},
+	"S21.G00.56.006": {"S21.G00.56.006", "Taux déclaré le mois de l'erreur", decimalRubric(FormatRate)},
+	"S21.G00.56.007": {"S21.G00.56.007", "Montant de la régularisation du prélèvement à la source", decimalRubric(FormatAmount)},
+	"S21.G00.56.008": {"S21.G00.56.008", "Régularisation du montant de la part non imposable du revenu", decimalRubric(FormatAmount)},
+	"S21.G00.56.009": {"S21.G00.56.009", "Régularisation du montant de l’abattement sur la base fiscale (non déduit de la rémunération nette fiscale)", decimalRubric(FormatAmount)},
+	"S21.G00.56.010": {"S21.G00.56.010", "Régularisation du montant soumis au PAS", decimalRubric(FormatAmount)},
+	"S21.G00.56.015": {"S21.G00.56.015", "Montant soumis au prélèvement à la source déclaré le mois de l'erreur", decimalRubric(FormatAmount)},
+	"S21.G00.58.001": {"S21.G00.58.001", "Date de début de période de rattachement", dateRubric()},
+	"S21.G00.58.002": {"S21.G00.58.002", "Date de fin de période de rattachement", dateRubric()},
+	"S21.G00.58.003": {"S21.G00.58.003", "Type", codeRubric(1, 8)},
+	"S21.G00.58.004": {"S21.G00.58.004", "Montant", decimalRubric(FormatAmount)},
+	"S21.G00.60.001": {"S21.G00.60.001", "Motif de l'arrêt", codeRubric(1, 8)},
+	"S21.G00.60.002": {"S21.G00.60.002", "Date du dernier jour travaillé", dateRubric()},
+	"S21.G00.60.003": {"S21.G00.60.003", "Date de fin prévisionnelle", dateRubric()},
+	"S21.G00.60.004": {"S21.G00.60.004", "Subrogation", codeRubric(2, 2)},
+	"S21.G00.60.005": {"S21.G00.60.005", "Date de début de subrogation", dateRubric()},
+	"S21.G00.60.006": {"S21.G00.60.006", "Date de fin de subrogation", dateRubric()},
+	"S21.G00.60.007": {"S21.G00.60.007", "IBAN", patternRubric(TypeAlphanumeric, 15, 34, ibanPattern)},
+	"S21.G00.60.008": {"S21.G00.60.008", "BIC", patternRubric(TypeAlphanumeric, 8, 11, bicPattern)},
+	"S21.G00.60.010": {"S21.G00.60.010", "Date de la reprise", dateRubric()},
+	"S21.G00.60.011": {"S21.G00.60.011", "Motif de la reprise", codeRubric(1, 8)},
+	"S21.G00.60.012": {"S21.G00.60.012", "Date de l'accident ou de la première constatation", dateRubric()},
+	"S21.G00.60.600": {"S21.G00.60.600", "SIRET Centralisateur", numericRubric(14, 14)},
+	"S21.G00.62.001": {"S21.G00.62.001", "Date de fin du contrat", dateRubric()},
+	"S21.G00.62.002": {"S21.G00.62.002", "Motif de la rupture du contrat", codeRubric(1, 8)},
+	"S21.G00.62.003": {"S21.G00.62.003", "Date de notification de la rupture de contrat", dateRubric()},
+	"S21.G00.62.004": {"S21.G00.62.004", "Date de signature de la convention de rupture", dateRubric()},
+	"S21.G00.62.005": {"S21.G00.62.005", "Date d'engagement de la procédure de licenciement", dateRubric()},
+	"S21.G00.62.006": {"S21.G00.62.006", "Dernier jour travaillé et payé au salaire habituel", dateRubric()},
+	"S21.G00.62.008": {"S21.G00.62.008", "Transaction en cours", codeRubric(2, 2)},
+	"S21.G00.62.011": {"S21.G00.62.011", "Nombre de mois de préavis utilisés dans le cadre du calcul CSP", numericRubric(1, 6)},
+	"S21.G00.62.013": {"S21.G00.62.013", "Montant de l'indemnité de préavis qui aurait été versée", decimalRubric(FormatAmount)},
+	"S21.G00.62.014": {"S21.G00.62.014", "Statut particulier du salarié", codeRubric(1, 8)},
+	"S21.G00.62.016": {"S21.G00.62.016", "Maintien de l'affiliation du salarié au contrat collectif ", codeRubric(2, 2)},
+	"S21.G00.62.017": {"S21.G00.62.017", "Modalité de déclaration de la fin du contrat d'usage", codeRubric(1, 8)},
+	"S21.G00.62.018": {"S21.G00.62.018", "Nombre de mois de préavis utilisés dans le cadre du calcul PAP", numericRubric(1, 6)},
+	"S21.G00.62.019": {"S21.G00.62.019", "Solde de congés acquis et non pris (ENIM)", codeRubric(1, 8)},
+	"S21.G00.62.020": {"S21.G00.62.020", "Mois de la DSN mensuelle portant les derniers éléments déclarés dans le FCTU", dateRubric()},
+	"S21.G00.63.001": {"S21.G00.63.001", "Type réalisation et paiement du préavis", codeRubric(1, 8)},
+	"S21.G00.63.002": {"S21.G00.63.002", "Date de début de préavis", dateRubric()},
+	"S21.G00.63.003": {"S21.G00.63.003", "Date de fin de préavis", dateRubric()},
+	"S21.G00.65.001": {"S21.G00.65.001", "Motif de suspension", codeRubric(1, 8)},
+	"S21.G00.65.002": {"S21.G00.65.002", "Date de début de la suspension", dateRubric()},
+	"S21.G00.65.003": {"S21.G00.65.003", "Date de fin de la suspension", dateRubric()},
+	"S21.G00.65.004": {"S21.G00.65.004", "[FP] Position de détachement", codeRubric(1, 8)},
+	"S21.G00.65.005": {"S21.G00.65.005", "Nombre de jours ouvrés de suspension", numericRubric(1, 10)},
+	"S21.G00.66.001": {"S21.G00.66.001", "Date de début", dateRubric()},
+	"S21.G00.66.002": {"S21.G00.66.002", "Date de fin", dateRubric()},
+	"S21.G00.66.003": {"S21.G00.66.003", "Montant", decimalRubric(FormatAmount)},
+	"S21.G00.70.004": {"S21.G00.70.004", "Code option retenue par le salarié", codeRubric(1, 8)},
+	"S21.G00.70.005": {"S21.G00.70.005", "Code population de rattachement", codeRubric(1, 8)},
+	"S21.G00.70.007": {"S21.G00.70.007", "Nombre d'enfants à charge", numericRubric(1, 10)},
+	"S21.G00.70.008": {"S21.G00.70.008", "Nombre d'adultes ayants-droit (conjoint, concubin, ...)", numericRubric(1, 10)},
+	"S21.G00.70.009": {"S21.G00.70.009", "Nombre d'ayants-droit", numericRubric(1, 10)},
+	"S21.G00.70.010": {"S21.G00.70.010", "Nombre d'ayants-droit autres (ascendants, collatéraux...)", numericRubric(1, 10)},
+	"S21.G00.70.011": {"S21.G00.70.011", "Nombre d'enfants ayants-droit", numericRubric(1, 10)},
+	"S21.G00.70.012": {"S21.G00.70.012", "Identifiant technique Affiliation", textRubric(1, 40)},
+	"S21.G00.70.013": {"S21.G00.70.013", "Identifiant technique Adhésion", textRubric(1, 40)},
+	"S21.G00.70.014": {"S21.G00.70.014", "Date de début de l'affiliation", dateRubric()},
+	"S21.G00.70.015": {"S21.G00.70.015", "Date de fin de l'affiliation", dateRubric()},
+	"S21.G00.71.002": {"S21.G00.71.002", "Code régime Retraite Complémentaire", codeRubric(1, 8)},
+	"S21.G00.71.003": {"S21.G00.71.003", "Référence adhésion employeur", textRubric(1, 40)},
+	"S21.G00.72.001": {"S21.G00.72.001", "Code régime Retraite Complémentaire déclaré à tort", codeRubric(1, 8)},
+	"S21.G00.72.002": {"S21.G00.72.002", "Référence adhésion employeur déclarée à tort", textRubric(1, 40)},
+	"S21.G00.73.001": {"S21.G00.73.001", "Régime local Alsace-Moselle", codeRubric(2, 2)},
+	"S21.G00.73.002": {"S21.G00.73.002", "Code option", codeRubric(1, 8)},
+	"S21.G00.73.003": {"S21.G00.73.003", "Type", codeRubric(1, 8)},
+	"S21.G00.73.004": {"S21.G00.73.004", "Date de début de rattachement à l'ouvrant-droit", dateRubric()},
+	"S21.G00.73.005": {"S21.G00.73.005", "Date de naissance", dateRubric()},
+	"S21.G00.73.006": {"S21.G00.73.006", "Nom de famille", textRubric(1, 80)},
+	"S21.G00.73.007": {"S21.G00.73.007", "Numéro d'inscription au répertoire", patternRubric(TypeAlphanumeric, 13, 15, nirPattern)},
+	"S21.G00.73.008": {"S21.G00.73.008", "NIR ouvrant-droit régime de base maladie", patternRubric(TypeAlphanumeric, 13, 15, nirPattern)},
+	"S21.G00.73.009": {"S21.G00.73.009", "Prénoms", textRubric(1, 80)},
+	"S21.G00.73.010": {"S21.G00.73.010", "Code organisme d'affiliation à l'assurance maladie", textRubric(1, 40)},
+	"S21.G00.73.011": {"S21.G00.73.011", "Date de fin de rattachement à l'ouvrant-droit", dateRubric()},
+	"S21.G00.78.001": {"S21.G00.78.001", "Code de base assujettie", codeRubric(1, 8)},
+	"S21.G00.78.002": {"S21.G00.78.002", "Date de début de période de rattachement", dateRubric()},
+	"S21.G00.78.003": {"S21.G00.78.003", "Date de fin de période de rattachement", dateRubric()},
+	"S21.G00.78.004": {"S21.G00.78.004", "Montant", decimalRubric(FormatAmount)},
+	"S21.G00.78.005": {"S21.G00.78.005", "Identifiant technique Affiliation", textRubric(1, 40)},
+	"S21.G00.78.006": {"S21.G00.78.006", "Numéro du contrat", textRubric(1, 40)},
+	"S21.G00.78.007": {"S21.G00.78.007", "Identifiant du CRM à l'origine de la régularisation", textRubric(1, 40)},
+	"S21.G00.79.001": {"S21.G00.79.001", "Type de composant de base assujettie", codeRubric(1, 8)},
+	"S21.G00.79.004": {"S21.G00.79.004", "Montant de composant de base assujettie", decimalRubric(FormatAmount)},
+	"S21.G00.79.005": {"S21.G00.79.005", "Identifiant du CRM à l'origine de la régularisation", textRubric(1, 40)},
+	"S21.G00.81.001": {"S21.G00.81.001", "Code de cotisation", codeRubric(1, 8)},
+	"S21.G00.81.002": {"S21.G00.81.002", "Identifiant Organisme de Protection Sociale", textRubric(1, 40)},
+	"S21.G00.81.003": {"S21.G00.81.003", "Montant d'assiette", decimalRubric(FormatAmount)},
+	"S21.G00.81.004": {"S21.G00.81.004", "Montant de cotisation", decimalRubric(FormatAmount)},
+	"S21.G00.81.005": {"S21.G00.81.005", "Code INSEE commune", patternRubric(TypeCode, 5, 5, inseePattern)},
+	"S21.G00.81.006": {"S21.G00.81.006", "Identifiant du CRM à l'origine de la régularisation", textRubric(1, 40)},
+	"S21.G00.81.007": {"S21.G00.81.007", "Taux de cotisation", decimalRubric(FormatRate)},
+	"S21.G00.82.001": {"S21.G00.82.001", "Valeur", decimalRubric(FormatAmount)},
+	"S21.G00.82.002": {"S21.G00.82.002", "Code de cotisation", codeRubric(1, 8)},
+	"S21.G00.82.003": {"S21.G00.82.003", "Date de début de période de rattachement", dateRubric()},
+	"S21.G00.82.004": {"S21.G00.82.004", "Date de fin de période de rattachement", dateRubric()},
+	"S21.G00.82.005": {"S21.G00.82.005", "Référence réglementaire ou contractuelle", textRubric(1, 40)},
+	"S21.G00.82.006": {"S21.G00.82.006", "Identifiant du CRM à l'origine de la régularisation", textRubric(1, 40)},
+	"S21.G00.83.001": {"S21.G00.83.001", "Date de début de période déclarée à tort", dateRubric()},
+	"S21.G00.83.002": {"S21.G00.83.002", "Date de fin de période déclarée à tort ", dateRubric()},
+	"S21.G00.84.001": {"S21.G00.84.001", "Code de base assujettie déclarée à tort", codeRubric(1, 8)},
+	"S21.G00.84.002": {"S21.G00.84.002", "Date de début de période de rattachement de la base déclarée à tort", dateRubric()},
+	"S21.G00.84.003": {"S21.G00.84.003", "Date de fin de période de rattachement de la base déclarée à tort", dateRubric()},
+	"S21.G00.84.004": {"S21.G00.84.004", "Montant déclaré à tort", decimalRubric(FormatAmount)},
+	"S21.G00.84.005": {"S21.G00.84.005", "Numéro du contrat rattaché à la base assujettie déclarée à tort", textRubric(1, 40)},
+	"S21.G00.85.001": {"S21.G00.85.001", "Identifiant du lieu de travail ou de l'établissement utilisateur", numericRubric(14, 14)},
+	"S21.G00.85.002": {"S21.G00.85.002", "Code APET", patternRubric(TypeCode, 5, 5, apePattern)},
+	"S21.G00.85.003": {"S21.G00.85.003", "Numéro, extension, nature, libellé de voie", textRubric(1, 50)},
+	"S21.G00.85.004": {"S21.G00.85.004", "Code postal", numericRubric(5, 5)},
+	"S21.G00.85.005": {"S21.G00.85.005", "Localité", textRubric(1, 50)},
+	"S21.G00.85.006": {"S21.G00.85.006", "Code Pays", patternRubric(TypeCode, 2, 2, countryPattern)},
+	"S21.G00.85.007": {"S21.G00.85.007", "Code de distribution à l'étranger", textRubric(1, 50)},
+	"S21.G00.85.008": {"S21.G00.85.008", "Complément de la localisation de la construction", textRubric(1, 50)},
+	"S21.G00.85.009": {"S21.G00.85.009", "Service de distribution, complément de localisation de la voie", textRubric(1, 50)},
+	"S21.G00.85.010": {"S21.G00.85.010", "Nature juridique", codeRubric(1, 8)},
+	"S21.G00.85.011": {"S21.G00.85.011", "Code INSEE commune", patternRubric(TypeCode, 5, 5, inseePattern)},
+	"S21.G00.85.012": {"S21.G00.85.012", "Code civilité", codeRubric(1, 8)},
+	"S21.G00.85.013": {"S21.G00.85.013", "Nom de famille", textRubric(1, 80)},
+	"S21.G00.85.014": {"S21.G00.85.014", "Nom d'usage", textRubric(1, 80)},
+	"S21.G00.85.015": {"S21.G00.85.015", "Prénoms", textRubric(1, 80)},
+	"S21.G00.85.016": {"S21.G00.85.016", "Date de naissance", dateRubric()},
+	"S21.G00.85.017": {"S21.G00.85.017", "Lieu de naissance", textRubric(1, 50)},
+	"S21.G00.86.001": {"S21.G00.86.001", "Type", codeRubric(1, 8)},
+	"S21.G00.86.002": {"S21.G00.86.002", "Unité de mesure", codeRubric(1, 8)},
+	"S21.G00.86.003": {"S21.G00.86.003", "Valeur", numericRubric(1, 10)},
+	"S21.G00.86.005": {"S21.G00.86.005", "Numéro du contrat", textRubric(1, 40)},
+	"S21.G00.95.001": {"S21.G00.95.001", "Code de base assujettie déclarée à tort", codeRubric(1, 8)},
+	"S21.G00.95.002": {"S21.G00.95.002", "Date de début de période de rattachement de la base déclarée à tort", dateRubric()},
+	"S21.G00.95.003": {"S21.G00.95.003", "Date de fin de période de rattachement de la base déclarée à tort", dateRubric()},
+	"S21.G00.95.004": {"S21.G00.95.004", "Montant déclaré à tort", decimalRubric(FormatAmount)},
+	"S21.G00.95.005": {"S21.G00.95.005", "Numéro du contrat rattaché à la base assujettie déclarée à tort", textRubric(1, 40)},
+	"S21.G00.98.001": {"S21.G00.98.001", "Identifiant de la SATD", textRubric(1, 40)},
+	"S21.G00.98.002": {"S21.G00.98.002", "Etat de prise en compte de la SATD", codeRubric(1, 8)},
+	"S21.G00.98.003": {"S21.G00.98.003", "Montant", decimalRubric(FormatAmount)},
+	"S21.G00.98.004": {"S21.G00.98.004", "Mois de l’erreur", dateRubric()},
+	"S89.G00.32.001": {"S89.G00.32.001", "Profession ou qualité", textRubric(1, 80)},
+	"S89.G00.32.002": {"S89.G00.32.002", "Nom du bénéficiaire des honoraires", textRubric(1, 80)},
+	"S89.G00.32.003": {"S89.G00.32.003", "Prénom du bénéficiaire des honoraires", textRubric(1, 80)},
+	"S89.G00.32.004": {"S89.G00.32.004", "Siren du bénéficiaire des honoraires", numericRubric(9, 9)},
+	"S89.G00.32.005": {"S89.G00.32.005", "Nic du bénéficiaire des honoraires", numericRubric(5, 5)},
+	"S89.G00.32.006": {"S89.G00.32.006", "Raison sociale du bénéficiaire des honoraires", textRubric(1, 80)},
+	"S89.G00.32.007": {"S89.G00.32.007", "Complément de localisation de la construction", textRubric(1, 50)},
+	"S89.G00.32.008": {"S89.G00.32.008", "Numéro, extension, nature et libellé de la voie", textRubric(1, 50)},
+	"S89.G00.32.009": {"S89.G00.32.009", "Code INSEE de la commune", patternRubric(TypeCode, 5, 5, inseePattern)},
+	"S89.G00.32.010": {"S89.G00.32.010", "Service de distribution, complément de localisation de la voie", textRubric(1, 50)},
+	"S89.G00.32.011": {"S89.G00.32.011", "Code postal", numericRubric(5, 5)},
+	"S89.G00.32.012": {"S89.G00.32.012", "Localité", textRubric(1, 50)},
+	"S89.G00.32.013": {"S89.G00.32.013", "Code pays", patternRubric(TypeCode, 2, 2, countryPattern)},
+	"S89.G00.32.014": {"S89.G00.32.014", "Code de distribution à l'étranger", textRubric(1, 50)},
+	"S89.G00.32.015": {"S89.G00.32.015", "Code taux réduit ou dispense de retenue à la source", codeRubric(1, 8)},
+	"S89.G00.32.016": {"S89.G00.32.016", "Montant TVA droits d'auteurs", decimalRubric(FormatAmount)},
+	"S89.G00.32.017": {"S89.G00.32.017", "Millésime de rattachement", numericRubric(4, 4)},
+	"S89.G00.33.001": {"S89.G00.33.001", "Code type avantage en nature", codeRubric(1, 8)},
+	"S89.G00.33.002": {"S89.G00.33.002", "Montant avantage en nature", decimalRubric(FormatAmount)},
+	"S89.G00.35.001": {"S89.G00.35.001", "Code modalité de prise en charge des indemnités", codeRubric(1, 8)},
+	"S89.G00.35.002": {"S89.G00.35.002", "Montant de l'indemnité", decimalRubric(FormatAmount)},
+	"S89.G00.43.001": {"S89.G00.43.001", "Code type de la rémunération", codeRubric(1, 8)},
+	"S89.G00.43.002": {"S89.G00.43.002", "Montant de la rémunération", decimalRubric(FormatAmount)},
+	"S89.G00.67.001": {"S89.G00.67.001", "NIR", patternRubric(TypeAlphanumeric, 13, 15, nirPattern)},
+	"S89.G00.67.002": {"S89.G00.67.002", "Numéro technique temporaire", textRubric(1, 40)},
+	"S89.G00.67.003": {"S89.G00.67.003", "Montant de droit supplémentaire acquis ", decimalRubric(FormatAmount)},
+	"S89.G00.67.004": {"S89.G00.67.004", "Pourcentage de droit supplémentaire acquis", decimalRubric(FormatRate)},
+	"S89.G00.67.005": {"S89.G00.67.005", "Millésime de rattachement", numericRubric(4, 4)},
+	"S89.G00.87.001": {"S89.G00.87.001", "Code contexte", codeRubric(1, 8)},
+	"S89.G00.87.002": {"S89.G00.87.002", "Nombre d'actions", numericRubric(1, 10)},
+	"S89.G00.87.003": {"S89.G00.87.003", "Valeur unitaire de l'action", decimalRubric(FormatAmount)},
+	"S89.G00.87.004": {"S89.G00.87.004", "Fraction du gain d'acquisition de source française", decimalRubric(FormatRate)},
+	"S89.G00.87.005": {"S89.G00.87.005", "Date d'attribution", dateRubric()},
+	"S89.G00.87.006": {"S89.G00.87.006", "Date d'acquisition définitive", dateRubric()},
+	"S89.G00.87.007": {"S89.G00.87.007", "Numéro d'inscription au répertoire", patternRubric(TypeAlphanumeric, 13, 15, nirPattern)},
+	"S89.G00.87.008": {"S89.G00.87.008", "Numéro technique temporaire", textRubric(1, 40)},
+	"S89.G00.88.001": {"S89.G00.88.001", "Code contexte", codeRubric(1, 8)},
+	"S89.G00.88.002": {"S89.G00.88.002", "Nombre d'options", numericRubric(1, 10)},
+	"S89.G00.88.003": {"S89.G00.88.003", "Valeur unitaire de l'action", decimalRubric(FormatAmount)},
+	"S89.G00.88.004": {"S89.G00.88.004", "Prix de souscription de l'action", decimalRubric(FormatAmount)},
+	"S89.G00.88.005": {"S89.G00.88.005", "Fraction du gain de levée d'option de source française", decimalRubric(FormatRate)},
+	"S89.G00.88.006": {"S89.G00.88.006", "Date d'attribution", dateRubric()},
+	"S89.G00.88.007": {"S89.G00.88.007", "Date de levée de l'option", dateRubric()},
+	"S89.G00.88.008": {"S89.G00.88.008", "Numéro d'inscription au répertoire", patternRubric(TypeAlphanumeric, 13, 15, nirPattern)},
+	"S89.G00.88.009": {"S89.G00.88.009", "Numéro technique temporaire", textRubric(1, 40)},
+	"S89.G00.89.001": {"S89.G00.89.001", "Nombre de titres", numericRubric(1, 10)},
+	"S89.G00.89.002": {"S89.G00.89.002", "Prix d'acquisition des titres", decimalRubric(FormatAmount)},
+	"S89.G00.89.003": {"S89.G00.89.003", "Valeur unitaire des titres au jour de l'exercice des bons", decimalRubric(FormatAmount)},
+	"S89.G00.89.004": {"S89.G00.89.004", "Fraction du gain de source française", decimalRubric(FormatRate)},
+	"S89.G00.89.005": {"S89.G00.89.005", "Date d'acquisition des titres", dateRubric()},
+	"S89.G00.89.006": {"S89.G00.89.006", "Durée d'exercice de l'activité du bénéficiaire dans l'entreprise", numericRubric(1, 10)},
+	"S89.G00.89.007": {"S89.G00.89.007", "Numéro d'inscription au répertoire", patternRubric(TypeAlphanumeric, 13, 15, nirPattern)},
+	"S89.G00.89.008": {"S89.G00.89.008", "Numéro technique temporaire", textRubric(1, 40)},
+	"S89.G00.91.001": {"S89.G00.91.001", "Numéro d'inscription au répertoire", patternRubric(TypeAlphanumeric, 13, 15, nirPattern)},
+	"S89.G00.91.002": {"S89.G00.91.002", "Nom de famille", textRubric(1, 80)},
+	"S89.G00.91.003": {"S89.G00.91.003", "Nom d'usage", textRubric(1, 80)},
+	"S89.G00.91.004": {"S89.G00.91.004", "Prénoms", textRubric(1, 80)},
+	"S89.G00.91.005": {"S89.G00.91.005", "Sexe", codeRubric(2, 2)},
+	"S89.G00.91.006": {"S89.G00.91.006", "Date de naissance", dateRubric()},
+	"S89.G00.91.007": {"S89.G00.91.007", "Lieu de naissance", textRubric(1, 50)},
+	"S89.G00.91.008": {"S89.G00.91.008", "Numéro, extension, nature et libellé de la voie", textRubric(1, 50)},
+	"S89.G00.91.009": {"S89.G00.91.009", "Code postal", numericRubric(5, 5)},
+	"S89.G00.91.010": {"S89.G00.91.010", "Localité", textRubric(1, 50)},
+	"S89.G00.91.011": {"S89.G00.91.011", "Code pays", patternRubric(TypeCode, 2, 2, countryPattern)},
+	"S89.G00.91.012": {"S89.G00.91.012", "Code de distribution à l'étranger", textRubric(1, 50)},
+	"S89.G00.91.013": {"S89.G00.91.013", "Complément de la localisation de la construction", textRubric(1, 50)},
+	"S89.G00.91.014": {"S89.G00.91.014", "Service de distribution, complément de localisation de la voie", textRubric(1, 50)},
+	"S89.G00.91.015": {"S89.G00.91.015", "Adresse mél", patternRubric(TypeAlphanumeric, 3, 100, emailPattern)},
+	"S89.G00.91.016": {"S89.G00.91.016", "Matricule de l'individu dans l'entreprise", textRubric(1, 40)},
+	"S89.G00.91.017": {"S89.G00.91.017", "Statut du salarié (conventionnel)", codeRubric(1, 8)},
+	"S89.G00.91.018": {"S89.G00.91.018", "Code statut catégoriel Retraite Complémentaire obligatoire", codeRubric(1, 8)},
+	"S89.G00.91.019": {"S89.G00.91.019", "Code département de naissance", patternRubric(TypeCode, 2, 3, departmentPattern)},
+	"S89.G00.91.020": {"S89.G00.91.020", "Code pays de naissance", patternRubric(TypeCode, 2, 2, countryPattern)},
+	"S89.G00.91.021": {"S89.G00.91.021", "Numéro technique temporaire", textRubric(1, 40)},
+	"S89.G00.92.001": {"S89.G00.92.001", "Type", codeRubric(1, 8)},
+	"S89.G00.92.002": {"S89.G00.92.002", "Code de base spécifique", codeRubric(1, 8)},
+	"S89.G00.92.003": {"S89.G00.92.003", "Montant", decimalRubric(FormatAmount)},
+	"S89.G00.92.004": {"S89.G00.92.004", "Date de début de période de rattachement", dateRubric()},
+	"S89.G00.92.005": {"S89.G00.92.005", "Date de fin de période de rattachement", dateRubric()},
+	"S89.G00.92.006": {"S89.G00.92.006", "Montant net fiscal du revenu versé", decimalRubric(FormatAmount)},
+	"S89.G00.92.007": {"S89.G00.92.007", "Taux de prélèvement à la source", decimalRubric(FormatRate)},
+	"S89.G00.92.008": {"S89.G00.92.008", "Type du taux de prélèvement à la source", codeRubric(1, 8)},
+	"S89.G00.92.009": {"S89.G00.92.009", "Identifiant du taux de prélèvement à la source", textRubric(1, 40)},
+	"S89.G00.92.010": {"S89.G00.92.010", "Montant de prélèvement à la source", decimalRubric(FormatAmount)},
+	"S89.G00.92.011": {"S89.G00.92.011", "Date de versement", dateRubric()},
+	"S89.G00.92.012": {"S89.G00.92.012", "Montant de la part non imposable du revenu", decimalRubric(FormatAmount)},
+	"S89.G00.92.013": {"S89.G00.92.013", "Montant soumis au PAS ", decimalRubric(FormatAmount)},
+	"S89.G00.92.014": {"S89.G00.92.014", "Montant de l’abattement sur la base fiscale (non déduit du montant net fiscal du revenu versé)", decimalRubric(FormatAmount)},
+	"S89.G00.92.015": {"S89.G00.92.015", "Identifiant du CRM à l'origine de la régularisation", textRubric(1, 40)},
+	"S89.G00.92.016": {"S89.G00.92.016", "Montant net versé", decimalRubric(FormatAmount)},
+	"S89.G00.92.017": {"S89.G00.92.017", "Montant net social", decimalRubric(FormatAmount)},
+	"S89.G00.93.001": {"S89.G00.93.001", "Mois de l'erreur", dateRubric()},
+	"S89.G00.93.002": {"S89.G00.93.002", "Type d'erreur", codeRubric(1, 8)},
+	"S89.G00.93.003": {"S89.G00.93.003", "Régularisation du montant net fiscal du revenu versé", decimalRubric(FormatAmount)},
+	"S89.G00.93.004": {"S89.G00.93.004", "Montant net fiscal du revenu versé le mois de l’erreur", decimalRubric(FormatAmount)},
+	"S89.G00.93.005": {"S89.G00.93.005", "Régularisation du taux de prélèvement à la source", decimalRubric(FormatRate)},
+	"S89.G00.93.006": {"S89.G00.93.006", "Taux déclaré le mois de l'erreur", decimalRubric(FormatRate)},
+	"S89.G00.93.007": {"S89.G00.93.007", "Montant de la régularisation du prélèvement à la source", decimalRubric(FormatAmount)},
+	"S89.G00.93.008": {"S89.G00.93.008", "Régularisation du montant de la part non imposable du revenu", decimalRubric(FormatAmount)},
+	"S89.G00.93.009": {"S89.G00.93.009", "Régularisation du montant soumis au PAS ", decimalRubric(FormatAmount)},
+	"S89.G00.93.010": {"S89.G00.93.010", "Régularisation du montant de l’abattement sur la base fiscale (non déduit du montant net fiscal du revenu versé)", decimalRubric(FormatAmount)},
+	"S89.G00.93.013": {"S89.G00.93.013", "Montant soumis au prélèvement à la source déclaré le mois de l'erreur", decimalRubric(FormatAmount)},
+	"S89.G00.94.001": {"S89.G00.94.001", "Code de cotisation", codeRubric(1, 8)},
+	"S89.G00.94.002": {"S89.G00.94.002", "Montant de cotisation ", decimalRubric(FormatAmount)},
+	"S90.G00.90.001": {"S90.G00.90.001", "Nombre total de rubriques", numericRubric(1, 6)},
+	"S90.G00.90.002": {"S90.G00.90.002", "Nombre de DSN", numericRubric(1, 6)},
 }
 
 // GetBloc returns the Bloc for a given BlocID
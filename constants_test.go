@@ -0,0 +1,48 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSchemaValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  Schema
+		value   string
+		wantErr string
+	}{
+		{name: "text", schema: textRubric(1, 80), value: "Hélène"},
+		{name: "length counted in characters", schema: textRubric(1, 6), value: "Hélène"},
+		{name: "too short", schema: textRubric(2, 80), value: "A", wantErr: "expected between 2 and 80"},
+		{name: "too long", schema: numericRubric(1, 6), value: "1234567", wantErr: "expected between 1 and 6"},
+		{name: "numeric", schema: numericRubric(14, 14), value: "12345678900012"},
+		{name: "not numeric", schema: numericRubric(1, 6), value: "12a", wantErr: "is not numeric"},
+		{name: "date", schema: dateRubric(), value: "20250331"},
+		{name: "not a date", schema: dateRubric(), value: "20250231", wantErr: "is not a date"},
+		{name: "amount", schema: decimalRubric(FormatAmount), value: "-1234.50"},
+		{name: "amount with a comma", schema: decimalRubric(FormatAmount), value: "1234,50", wantErr: "does not match"},
+		{name: "rate with two decimals", schema: decimalRubric(FormatRate), value: "16.04", wantErr: "does not match"},
+		{name: "pattern", schema: patternRubric(TypeAlphanumeric, 3, 100, emailPattern), value: "zoe@example.com"},
+		{name: "pattern mismatch", schema: patternRubric(TypeAlphanumeric, 3, 100, emailPattern), value: "zoe.example.com", wantErr: "does not match"},
+		{name: "code", schema: codeRubric(2, 2, Yes, No), value: Yes},
+		{name: "code out of the list", schema: codeRubric(2, 2, Yes, No), value: "03", wantErr: "is not one of"},
+		{name: "open code list", schema: codeRubric(1, 8), value: "042"},
+		{name: "code with a space", schema: codeRubric(1, 8), value: "04 2", wantErr: "does not match"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.schema.Validate(tt.value)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Validate(%q) error = %v", tt.value, err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Validate(%q) error = %v, want %q", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -5,10 +5,14 @@ package main
 
 import (
 	"bufio"
+	"flag"
 	"fmt"
+	"io"
 	"log"
+	"math"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,7 +22,6 @@ import (
 // TODO(vm): S20.G00.07
 // TODO(vm): S20.G00.08
 // TODO(vm): S21.G00.12
-// TODO(vm): S21.G00.13
 // TODO(vm): S21.G00.15
 // TODO(vm): S21.G00.16
 // TODO(vm): S21.G00.20
@@ -52,25 +55,25 @@ func GenerateTransmission() Transmission {
 		PublisherName:    fake.Company(),
 		SoftwareVersion:  fake.AppVersion(),
 		PreCheckCode:     fake.DigitN(1),
-		FileType:         fake.RandomString([]string{"01", "02"}),
+		FileType:         drawCode("S10.G00.00.005"),
 		StandardVersion:  "P24V01", // This is typically a fixed value for a given period
-		SubmissionPoint:  fake.DigitN(2),
-		TransmissionType: fake.DigitN(2),
+		SubmissionPoint:  drawCode("S10.G00.00.007"),
+		TransmissionType: drawCode("S10.G00.00.008"),
 	}
 }
 
 // French: Émetteur
 type Sender struct {
-	SirenNumber         string `dsn:"S10.G00.01.001"` // French: Siren de l'émetteur de l'envoi
-	NicNumber           string `dsn:"S10.G00.01.002"` // French: Nic de l'émetteur de l'envoi
-	Name                string `dsn:"S10.G00.01.003"` // French: Nom ou raison sociale de l'émetteur
-	StreetAddress       string `dsn:"S10.G00.01.004"` // French: Numéro, extension, nature et libellé de la voie
-	PostalCode          string `dsn:"S10.G00.01.005"` // French: Code postal
-	City                string `dsn:"S10.G00.01.006"` // French: Localité
-	CountryCode         string `dsn:"S10.G00.01.007"` // French: Code pays
-	ForeignDistribution string `dsn:"S10.G00.01.008"` // French: Code de distribution à l'étranger
-	BuildingComplement  string `dsn:"S10.G00.01.009"` // French: Complément de la localisation de la construction
-	DeliveryService     string `dsn:"S10.G00.01.010"` // French: Service de distribution, complément de localisation de la voie
+	SirenNumber         string `dsn:"S10.G00.01.001"`       // French: Siren de l'émetteur de l'envoi
+	NicNumber           string `dsn:"S10.G00.01.002"`       // French: Nic de l'émetteur de l'envoi
+	Name                string `dsn:"S10.G00.01.003"`       // French: Nom ou raison sociale de l'émetteur
+	StreetAddress       string `dsn:"S10.G00.01.004"`       // French: Numéro, extension, nature et libellé de la voie
+	PostalCode          string `dsn:"S10.G00.01.005"`       // French: Code postal
+	City                string `dsn:"S10.G00.01.006"`       // French: Localité
+	CountryCode         string `dsn:"S10.G00.01.007,upper"` // French: Code pays
+	ForeignDistribution string `dsn:"S10.G00.01.008"`       // French: Code de distribution à l'étranger
+	BuildingComplement  string `dsn:"S10.G00.01.009"`       // French: Complément de la localisation de la construction
+	DeliveryService     string `dsn:"S10.G00.01.010"`       // French: Service de distribution, complément de localisation de la voie
 }
 
 // GenerateSender creates a new Sender with random data
@@ -92,18 +95,17 @@ func GenerateSender() Sender {
 // SenderContact represents the contact information for the sender in the DSN
 // French: Contact Émetteur
 type SenderContact struct {
-	CivilityCode string `dsn:"S10.G00.02.001"` // French: Code civilité
-	FullName     string `dsn:"S10.G00.02.002"` // French: Nom et prénom de la personne à contacter
-	Email        string `dsn:"S10.G00.02.004"` // French: Adresse mél du contact émetteur
-	PhoneNumber  string `dsn:"S10.G00.02.005"` // French: Adresse téléphonique
-	FaxNumber    string `dsn:"S10.G00.02.006"` // French: Adresse fax
+	CivilityCode string `dsn:"S10.G00.02.001"`       // French: Code civilité
+	FullName     string `dsn:"S10.G00.02.002,upper"` // French: Nom et prénom de la personne à contacter
+	Email        string `dsn:"S10.G00.02.004"`       // French: Adresse mél du contact émetteur
+	PhoneNumber  string `dsn:"S10.G00.02.005"`       // French: Adresse téléphonique
+	FaxNumber    string `dsn:"S10.G00.02.006"`       // French: Adresse fax
 }
 
 // GenerateSenderContact creates a new SenderContact with random data
 func GenerateSenderContact() SenderContact {
-	codes := []string{"01", "02", "03"} // Example codes, adjust as needed
 	return SenderContact{
-		CivilityCode: sample(codes),
+		CivilityCode: drawCode("S10.G00.02.001"),
 		FullName:     gofakeit.Name(),
 		Email:        gofakeit.Email(),
 		PhoneNumber:  gofakeit.Phone(),
@@ -125,45 +127,45 @@ type Declaration struct {
 	BusinessID             string    `dsn:"S20.G00.05.009"` // French: Identifiant métier
 	Currency               string    `dsn:"S20.G00.05.010"` // French: Devise de la déclaration
 	TriggerEventNature     string    `dsn:"S20.G00.05.011"` // French: Nature de l'événement déclencheur du signalement
-	LastKnownSIRET         string    `dsn:"S20.G00.05.012"` // French: Dernier SIRET connu pour ancien numéro de contrat
+	LastKnownSIRET         SIRET     `dsn:"S20.G00.05.012"` // French: Dernier SIRET connu pour ancien numéro de contrat
 	SubstitutionDSNType    string    `dsn:"S20.G00.05.013"` // French: Type de nature de DSN de substitution
 }
 
 // GenerateDeclaration creates a new Declaration with random data
 func GenerateDeclaration() Declaration {
 	return Declaration{
-		Nature:                 sample([]string{"01", "02", "03"}),
-		Type:                   sample([]string{"01", "02", "03"}),
+		Nature:                 drawCode("S20.G00.05.001"),
+		Type:                   drawCode("S20.G00.05.002"),
 		FractionNumber:         gofakeit.DigitN(2),
 		OrderNumber:            gofakeit.DigitN(3),
-		MainDeclarationMonth:   gofakeit.Date(),
+		MainDeclarationMonth:   firstDayOfMonth(gofakeit.DateRange(time.Now().AddDate(-1, 0, 0), time.Now())),
 		CancelledDeclarationID: gofakeit.UUID(),
 		FileCreationDate:       gofakeit.Date(),
-		DeclarationField:       sample([]string{"01", "02", "03"}),
+		DeclarationField:       drawCode("S20.G00.05.008"),
 		BusinessID:             gofakeit.UUID(),
-		Currency:               "EUR", // Assuming Euro is the default currency
-		TriggerEventNature:     sample([]string{"01", "02", "03"}),
-		LastKnownSIRET:         gofakeit.DigitN(14),
-		SubstitutionDSNType:    sample([]string{"01", "02", "03"}),
+		Currency:               drawCode("S20.G00.05.010"),
+		TriggerEventNature:     drawCode("S20.G00.05.011"),
+		LastKnownSIRET:         SIRET{SIREN: gofakeit.DigitN(9), NIC: gofakeit.DigitN(5)},
+		SubstitutionDSNType:    drawCode("S20.G00.05.013"),
 	}
 }
 
 // Company represents the company information in the DSN
 // French: Entreprise
 type Company struct {
-	SIREN                   string `dsn:"S21.G00.06.001"` // French: SIREN
-	HeadquartersNIC         string `dsn:"S21.G00.06.002"` // French: NIC du siège
-	APENCode                string `dsn:"S21.G00.06.003"` // French: Code APEN
-	StreetAddress           string `dsn:"S21.G00.06.004"` // French: Numéro, extension, nature et libellé de la voie
-	PostalCode              string `dsn:"S21.G00.06.005"` // French: Code postal
-	City                    string `dsn:"S21.G00.06.006"` // French: Localité
-	BuildingComplement      string `dsn:"S21.G00.06.007"` // French: Complément de la localisation de la construction
-	DeliveryService         string `dsn:"S21.G00.06.008"` // French: Service de distribution, complément de localisation de la voie
-	AverageWorkforceOnDec31 int    `dsn:"S21.G00.06.009"` // French: Effectif moyen de l'entreprise au 31 décembre
-	CountryCode             string `dsn:"S21.G00.06.010"` // French: Code pays
-	ForeignDistribution     string `dsn:"S21.G00.06.011"` // French: Code de distribution à l'étranger
-	CompanyLocation         string `dsn:"S21.G00.06.012"` // French: Implantation de l'entreprise
-	CollectiveAgreementCode string `dsn:"S21.G00.06.015"` // French: Code convention collective applicable
+	SIREN                   string `dsn:"S21.G00.06.001"`       // French: SIREN
+	HeadquartersNIC         string `dsn:"S21.G00.06.002"`       // French: NIC du siège
+	APENCode                string `dsn:"S21.G00.06.003"`       // French: Code APEN
+	StreetAddress           string `dsn:"S21.G00.06.004"`       // French: Numéro, extension, nature et libellé de la voie
+	PostalCode              string `dsn:"S21.G00.06.005"`       // French: Code postal
+	City                    string `dsn:"S21.G00.06.006"`       // French: Localité
+	BuildingComplement      string `dsn:"S21.G00.06.007"`       // French: Complément de la localisation de la construction
+	DeliveryService         string `dsn:"S21.G00.06.008"`       // French: Service de distribution, complément de localisation de la voie
+	AverageWorkforceOnDec31 int    `dsn:"S21.G00.06.009"`       // French: Effectif moyen de l'entreprise au 31 décembre
+	CountryCode             string `dsn:"S21.G00.06.010,upper"` // French: Code pays
+	ForeignDistribution     string `dsn:"S21.G00.06.011"`       // French: Code de distribution à l'étranger
+	CompanyLocation         string `dsn:"S21.G00.06.012"`       // French: Implantation de l'entreprise
+	CollectiveAgreementCode string `dsn:"S21.G00.06.015"`       // French: Code convention collective applicable
 }
 
 // GenerateCompany creates a new Company with random data
@@ -180,7 +182,7 @@ func GenerateCompany() Company {
 		AverageWorkforceOnDec31: gofakeit.Number(1, 10000),
 		CountryCode:             gofakeit.CountryAbr(),
 		ForeignDistribution:     gofakeit.Word(),
-		CompanyLocation:         sample([]string{"01", "02", "03"}),
+		CompanyLocation:         drawCode("S21.G00.06.012"),
 		CollectiveAgreementCode: generateCollectiveAgreementCode(),
 	}
 }
@@ -188,34 +190,33 @@ func GenerateCompany() Company {
 // Helper functions to generate specific types of data
 func generateAPENCode() string {
 	// APEN code format: 4 digits + 1 letter
-	return gofakeit.DigitN(4) + gofakeit.Letter()
+	return gofakeit.DigitN(4) + strings.ToUpper(gofakeit.Letter())
 }
 
 func generateCollectiveAgreementCode() string {
-	// Example format: 4 digits
-	return gofakeit.DigitN(4)
+	return drawCode("S21.G00.06.015")
 }
 
 // Establishment represents the establishment information in the DSN
 // French: Établissement
 type Establishment struct {
-	NIC                         string     `dsn:"S21.G00.11.001"` // French: NIC
-	APETCode                    string     `dsn:"S21.G00.11.002"` // French: Code APET
-	StreetAddress               string     `dsn:"S21.G00.11.003"` // French: Numéro, extension, nature et libellé de la voie
-	PostalCode                  string     `dsn:"S21.G00.11.004"` // French: Code postal
-	City                        string     `dsn:"S21.G00.11.005"` // French: Localité
-	BuildingComplement          string     `dsn:"S21.G00.11.006"` // French: Complément de la localisation de la construction
-	DeliveryService             string     `dsn:"S21.G00.11.007"` // French: Service de distribution, complément de localisation de la voie
-	WorkforceAtEndOfPeriod      int        `dsn:"S21.G00.11.008"` // French: Effectif de fin de période déclarée de l'établissement
-	ExpatRemunerationType       string     `dsn:"S21.G00.11.009"` // French: Type de rémunération soumise à contributions d'Assurance chômage pour expatriés
-	CountryCode                 string     `dsn:"S21.G00.11.015"` // French: Code pays
-	ForeignDistribution         string     `dsn:"S21.G00.11.016"` // French: Code de distribution à l'étranger
-	EmployerLegalNature         string     `dsn:"S21.G00.11.017"` // French: Nature juridique de l'employeur
-	TESECEAJoinDate             *time.Time `dsn:"S21.G00.11.019"` // French: Date d'effet de l'adhésion au dispositif TESE/CEA
-	TESECEAExitDate             *time.Time `dsn:"S21.G00.11.020"` // French: Date d'effet de la sortie du dispositif TESE/CEA
-	MainCollectiveAgreementCode string     `dsn:"S21.G00.11.022"` // French: Code convention collective principale
-	SkillsOperator              string     `dsn:"S21.G00.11.023"` // French: Opérateur de compétences (OPCO)
-	DSNExitRequest              string     `dsn:"S21.G00.11.024"` // French: Demande de sortie de la DSN
+	NIC                         string     `dsn:"S21.G00.11.001"`           // French: NIC
+	APETCode                    string     `dsn:"S21.G00.11.002"`           // French: Code APET
+	StreetAddress               string     `dsn:"S21.G00.11.003"`           // French: Numéro, extension, nature et libellé de la voie
+	PostalCode                  string     `dsn:"S21.G00.11.004"`           // French: Code postal
+	City                        string     `dsn:"S21.G00.11.005"`           // French: Localité
+	BuildingComplement          string     `dsn:"S21.G00.11.006"`           // French: Complément de la localisation de la construction
+	DeliveryService             string     `dsn:"S21.G00.11.007"`           // French: Service de distribution, complément de localisation de la voie
+	WorkforceAtEndOfPeriod      int        `dsn:"S21.G00.11.008"`           // French: Effectif de fin de période déclarée de l'établissement
+	ExpatRemunerationType       string     `dsn:"S21.G00.11.009"`           // French: Type de rémunération soumise à contributions d'Assurance chômage pour expatriés
+	CountryCode                 string     `dsn:"S21.G00.11.015,upper"`     // French: Code pays
+	ForeignDistribution         string     `dsn:"S21.G00.11.016"`           // French: Code de distribution à l'étranger
+	EmployerLegalNature         string     `dsn:"S21.G00.11.017"`           // French: Nature juridique de l'employeur
+	TESECEAJoinDate             *time.Time `dsn:"S21.G00.11.019,omitempty"` // French: Date d'effet de l'adhésion au dispositif TESE/CEA
+	TESECEAExitDate             *time.Time `dsn:"S21.G00.11.020,omitempty"` // French: Date d'effet de la sortie du dispositif TESE/CEA
+	MainCollectiveAgreementCode string     `dsn:"S21.G00.11.022"`           // French: Code convention collective principale
+	SkillsOperator              string     `dsn:"S21.G00.11.023"`           // French: Opérateur de compétences (OPCO)
+	DSNExitRequest              string     `dsn:"S21.G00.11.024"`           // French: Demande de sortie de la DSN
 }
 
 // GenerateEstablishment creates a new Establishment with random data
@@ -232,52 +233,161 @@ func GenerateEstablishment() Establishment {
 		BuildingComplement:          gofakeit.Name(),
 		DeliveryService:             gofakeit.Word(),
 		WorkforceAtEndOfPeriod:      gofakeit.Number(1, 1000),
-		ExpatRemunerationType:       sample([]string{"01", "02", "03"}),
+		ExpatRemunerationType:       drawCode("S21.G00.11.009"),
 		CountryCode:                 gofakeit.CountryAbr(),
 		ForeignDistribution:         gofakeit.Word(),
-		EmployerLegalNature:         sample([]string{"01", "02", "03"}),
+		EmployerLegalNature:         drawCode("S21.G00.11.017"),
 		TESECEAJoinDate:             &joinDate,
 		TESECEAExitDate:             &exitDate,
 		MainCollectiveAgreementCode: generateCollectiveAgreementCode(),
-		SkillsOperator:              sample([]string{"OPCO1", "OPCO2", "OPCO3"}),
-		DSNExitRequest:              sample([]string{"OPCO1", "OPCO2", "OPCO3"}),
+		SkillsOperator:              drawCode("S21.G00.11.023"),
+		DSNExitRequest:              drawCode("S21.G00.11.024"),
 	}
 }
 
 // Helper functions to generate specific types of data
 func generateAPETCode() string {
 	// APET code format: 4 digits + 1 letter
-	return gofakeit.DigitN(4) + gofakeit.Letter()
+	return gofakeit.DigitN(4) + strings.ToUpper(gofakeit.Letter())
+}
+
+// Workplace represents the place where a contract is performed, or the user
+// establishment of a temporary worker
+// French: Lieu de travail ou établissement utilisateur
+type Workplace struct {
+	ID                  string `dsn:"S21.G00.85.001"`       // Identifiant du lieu de travail ou de l'établissement utilisateur
+	APETCode            string `dsn:"S21.G00.85.002"`       // Code APET
+	StreetAddress       string `dsn:"S21.G00.85.003"`       // Numéro, extension, nature, libellé de voie
+	PostalCode          string `dsn:"S21.G00.85.004"`       // Code postal
+	City                string `dsn:"S21.G00.85.005"`       // Localité
+	CountryCode         string `dsn:"S21.G00.85.006,upper"` // Code Pays
+	ForeignDistribution string `dsn:"S21.G00.85.007"`       // Code de distribution à l'étranger
+	BuildingComplement  string `dsn:"S21.G00.85.008"`       // Complément de la localisation de la construction
+	DeliveryService     string `dsn:"S21.G00.85.009"`       // Service de distribution, complément de localisation de la voie
+	LegalNature         string `dsn:"S21.G00.85.010"`       // Nature juridique
+	INSEECityCode       string `dsn:"S21.G00.85.011"`       // Code INSEE commune
+}
+
+// GenerateWorkplace creates a new Workplace identified by its SIRET
+func GenerateWorkplace(siret string) Workplace {
+	return Workplace{
+		ID:                  siret,
+		APETCode:            generateAPETCode(),
+		StreetAddress:       gofakeit.Street(),
+		PostalCode:          gofakeit.Zip(),
+		City:                gofakeit.City(),
+		CountryCode:         "FR",
+		ForeignDistribution: gofakeit.Word(),
+		BuildingComplement:  gofakeit.Name(),
+		DeliveryService:     gofakeit.Word(),
+		LegalNature:         drawCode("S21.G00.85.010"),
+		INSEECityCode:       gofakeit.DigitN(5),
+	}
+}
+
+// GenerateEstablishmentWorkplace creates the Workplace of the declared establishment itself
+func GenerateEstablishmentWorkplace(company Company, establishment Establishment) Workplace {
+	workplace := GenerateWorkplace(company.SIREN + establishment.NIC)
+	workplace.APETCode = establishment.APETCode
+	workplace.StreetAddress = establishment.StreetAddress
+	workplace.PostalCode = establishment.PostalCode
+	workplace.City = establishment.City
+	workplace.BuildingComplement = establishment.BuildingComplement
+	workplace.DeliveryService = establishment.DeliveryService
+	workplace.LegalNature = establishment.EmployerLegalNature
+	return workplace
+}
+
+// DisabledWorkerComplement represents the complementary information of the
+// establishment on its obligation to employ disabled workers
+// French: Complément OETH
+type DisabledWorkerComplement struct {
+	ApprovedAgreement  string `dsn:"S21.G00.13.001"`           // Accord agréé OETH
+	ExternalBOETHType  string `dsn:"S21.G00.13.002,omitempty"` // Type BOETH externe
+	ExternalBOETHCount int    `dsn:"S21.G00.13.003"`           // Nombre BOETH externe
+	Vintage            string `dsn:"S21.G00.13.004"`           // Millésime de rattachement
+}
+
+const (
+	DisabledWorkerRQTH           = "01" // Reconnaissance de la qualité de travailleur handicapé
+	DisabledWorkerWorkAccident   = "02" // Victime d'accident du travail ou de maladie professionnelle
+	DisabledWorkerInvalidity     = "03" // Titulaire d'une pension d'invalidité
+	DisabledWorkerDisabilityCard = "08" // Titulaire de la carte d'invalidité ou de la carte mobilité inclusion
+
+	ExternalBOETHTrainee  = "01" // Stagiaire
+	ExternalBOETHPMSMP    = "02" // Période de mise en situation en milieu professionnel
+	ExternalBOETHSeconded = "03" // Salarié mis à disposition par une entreprise de travail temporaire ou un groupement d'employeurs
+)
+
+// disabledWorkerObligationRate is the share of the workforce that must be
+// disabled workers
+const disabledWorkerObligationRate = 0.06
+
+// generateDisabledWorkerStatuses draws the Contrat.DisabledWorkerStatus of
+// the n contracts of the establishment, empty for contracts not flagged BOETH
+func generateDisabledWorkerStatuses(n int) []string {
+	statuses := make([]string, n)
+	for i := range statuses {
+		if chance(disabledWorkerShare) {
+			statuses[i] = drawCode("S21.G00.40.072")
+		}
+	}
+	return statuses
+}
+
+// GenerateDisabledWorkerComplement creates the DisabledWorkerComplement of the
+// establishment for the previous year. External disabled workers make up for
+// part of the disabled workers missing among the contracts to meet the obligation.
+func GenerateDisabledWorkerComplement(statuses []string, month time.Time) DisabledWorkerComplement {
+	internal := 0
+	for _, status := range statuses {
+		if status != "" {
+			internal++
+		}
+	}
+
+	complement := DisabledWorkerComplement{
+		ApprovedAgreement: No,
+		Vintage:           strconv.Itoa(month.Year() - 1),
+	}
+
+	missing := int(math.Ceil(float64(len(statuses))*disabledWorkerObligationRate)) - internal
+	if missing > 0 {
+		complement.ExternalBOETHType = drawCode("S21.G00.13.002")
+		complement.ExternalBOETHCount = gofakeit.IntRange(1, missing)
+	}
+
+	return complement
 }
 
 // Individual represents the individual information in the DSN
 // French: Individu
 type Individual struct {
-	NIR                            string    `dsn:"S21.G00.30.001"` // French: Numéro d'inscription au répertoire
-	LastName                       string    `dsn:"S21.G00.30.002"` // French: Nom de famille
-	UsageName                      string    `dsn:"S21.G00.30.003"` // French: Nom d'usage
-	FirstNames                     string    `dsn:"S21.G00.30.004"` // French: Prénoms
-	Gender                         string    `dsn:"S21.G00.30.005"` // French: Sexe
-	BirthDate                      time.Time `dsn:"S21.G00.30.006"` // French: Date de naissance
-	BirthPlace                     string    `dsn:"S21.G00.30.007"` // French: Lieu de naissance
-	StreetAddress                  string    `dsn:"S21.G00.30.008"` // French: Numéro, extension, nature et libellé de la voie
-	PostalCode                     string    `dsn:"S21.G00.30.009"` // French: Code postal
-	City                           string    `dsn:"S21.G00.30.010"` // French: Localité
-	CountryCode                    string    `dsn:"S21.G00.30.011"` // French: Code pays
-	ForeignDistribution            string    `dsn:"S21.G00.30.012"` // French: Code de distribution à l'étranger
-	EUCodification                 string    `dsn:"S21.G00.30.013"` // French: Codification UE
-	BirthDepartmentCode            string    `dsn:"S21.G00.30.014"` // French: Code département de naissance
-	BirthCountryCode               string    `dsn:"S21.G00.30.015"` // French: Code pays de naissance
-	BuildingComplement             string    `dsn:"S21.G00.30.016"` // French: Complément de la localisation de la construction
-	DeliveryService                string    `dsn:"S21.G00.30.017"` // French: Service de distribution, complément de localisation de la voie
-	Email                          string    `dsn:"S21.G00.30.018"` // French: Adresse mél
-	CompanyID                      string    `dsn:"S21.G00.30.019"` // French: Matricule de l'individu dans l'entreprise
-	TemporaryTechnicalID           string    `dsn:"S21.G00.30.020"` // French: Numéro technique temporaire
-	ForeignTaxStatus               string    `dsn:"S21.G00.30.022"` // French: Statut à l'étranger au sens fiscal
-	RetirementEmploymentCumulation string    `dsn:"S21.G00.30.023"` // French: Cumul emploi retraite
-	HighestEducationLevel          string    `dsn:"S21.G00.30.024"` // French: Niveau de formation le plus élevé obtenu par l'individu
-	CurrentDiplomaLevel            string    `dsn:"S21.G00.30.025"` // French: Niveau de diplôme préparé par l'individu
-	BirthCountryName               string    `dsn:"S21.G00.30.029"` // French: Libellé du pays de naissance
+	NIR                            string    `dsn:"S21.G00.30.001"`       // French: Numéro d'inscription au répertoire
+	LastName                       string    `dsn:"S21.G00.30.002,upper"` // French: Nom de famille
+	UsageName                      string    `dsn:"S21.G00.30.003,upper"` // French: Nom d'usage
+	FirstNames                     string    `dsn:"S21.G00.30.004,upper"` // French: Prénoms
+	Gender                         string    `dsn:"S21.G00.30.005"`       // French: Sexe
+	BirthDate                      time.Time `dsn:"S21.G00.30.006"`       // French: Date de naissance
+	BirthPlace                     string    `dsn:"S21.G00.30.007"`       // French: Lieu de naissance
+	StreetAddress                  string    `dsn:"S21.G00.30.008"`       // French: Numéro, extension, nature et libellé de la voie
+	PostalCode                     string    `dsn:"S21.G00.30.009"`       // French: Code postal
+	City                           string    `dsn:"S21.G00.30.010"`       // French: Localité
+	CountryCode                    string    `dsn:"S21.G00.30.011,upper"` // French: Code pays
+	ForeignDistribution            string    `dsn:"S21.G00.30.012"`       // French: Code de distribution à l'étranger
+	EUCodification                 string    `dsn:"S21.G00.30.013"`       // French: Codification UE
+	BirthDepartmentCode            string    `dsn:"S21.G00.30.014"`       // French: Code département de naissance
+	BirthCountryCode               string    `dsn:"S21.G00.30.015,upper"` // French: Code pays de naissance
+	BuildingComplement             string    `dsn:"S21.G00.30.016"`       // French: Complément de la localisation de la construction
+	DeliveryService                string    `dsn:"S21.G00.30.017"`       // French: Service de distribution, complément de localisation de la voie
+	Email                          string    `dsn:"S21.G00.30.018"`       // French: Adresse mél
+	CompanyID                      string    `dsn:"S21.G00.30.019"`       // French: Matricule de l'individu dans l'entreprise
+	TemporaryTechnicalID           string    `dsn:"S21.G00.30.020"`       // French: Numéro technique temporaire
+	ForeignTaxStatus               string    `dsn:"S21.G00.30.022"`       // French: Statut à l'étranger au sens fiscal
+	RetirementEmploymentCumulation string    `dsn:"S21.G00.30.023"`       // French: Cumul emploi retraite
+	HighestEducationLevel          string    `dsn:"S21.G00.30.024"`       // French: Niveau de formation le plus élevé obtenu par l'individu
+	CurrentDiplomaLevel            string    `dsn:"S21.G00.30.025"`       // French: Niveau de diplôme préparé par l'individu
+	BirthCountryName               string    `dsn:"S21.G00.30.029"`       // French: Libellé du pays de naissance
 }
 
 // GenerateIndividual creates a new Individual with random data
@@ -298,7 +408,7 @@ func GenerateIndividual() Individual {
 		City:                           gofakeit.City(),
 		CountryCode:                    gofakeit.CountryAbr(),
 		ForeignDistribution:            gofakeit.Word(),
-		EUCodification:                 sample([]string{"01", "02", "03"}),
+		EUCodification:                 drawCode("S21.G00.30.013"),
 		BirthDepartmentCode:            generateDepartmentCode(),
 		BirthCountryCode:               gofakeit.CountryAbr(),
 		BuildingComplement:             gofakeit.Name(),
@@ -306,10 +416,10 @@ func GenerateIndividual() Individual {
 		Email:                          gofakeit.Email(),
 		CompanyID:                      gofakeit.DigitN(8),
 		TemporaryTechnicalID:           gofakeit.UUID(),
-		ForeignTaxStatus:               sample([]string{"01", "02", "03"}),
-		RetirementEmploymentCumulation: sample([]string{"01", "02", "03"}),
-		HighestEducationLevel:          sample([]string{"01", "02", "03", "04", "05", "06", "07", "08", "09", "10"}),
-		CurrentDiplomaLevel:            sample([]string{"01", "02", "03", "04", "05", "06", "07", "08", "09", "10"}),
+		ForeignTaxStatus:               drawCode("S21.G00.30.022"),
+		RetirementEmploymentCumulation: drawCode("S21.G00.30.023"),
+		HighestEducationLevel:          drawCode("S21.G00.30.024"),
+		CurrentDiplomaLevel:            drawCode("S21.G00.30.025"),
 		BirthCountryName:               birthCountry,
 	}
 }
@@ -320,8 +430,7 @@ const (
 )
 
 func generateGender() string {
-	genderInt := gofakeit.Number(1, 2)
-	return fmt.Sprintf("0%d", genderInt)
+	return drawCode("S21.G00.30.005")
 }
 
 // Helper functions to generate specific types of data
@@ -344,161 +453,199 @@ func generateDepartmentCode() string {
 }
 
 type Contrat struct {
-	ContractStartDate                 time.Time `dsn:"S21.G00.40.001"` // Date de début du contrat
-	EmployeeStatus                    string    `dsn:"S21.G00.40.002"` // Statut du salarié (conventionnel)
-	MandatorySupplementaryPensionCode string    `dsn:"S21.G00.40.003"` // Code statut catégoriel Retraite Complémentaire obligatoire
-	OccupationCode                    string    `dsn:"S21.G00.40.004"` // Code profession et catégorie socioprofessionnelle (PCS-ESE)
-	OccupationCodeExtension           string    `dsn:"S21.G00.40.005"` // Code complément PCS-ESE
-	JobTitle                          string    `dsn:"S21.G00.40.006"` // Libellé de l'emploi
-	ContractType                      string    `dsn:"S21.G00.40.007"` // Nature du contrat
-	PublicPolicyScheme                string    `dsn:"S21.G00.40.008"` // Dispositif de politique publique et conventionnel
-	ContractNumber                    string    `dsn:"S21.G00.40.009"` // Numéro du contrat
-	ExpectedEndDate                   time.Time `dsn:"S21.G00.40.010"` // Date de fin prévisionnelle du contrat
-	WorkTimeUnit                      string    `dsn:"S21.G00.40.011"` // Unité de mesure de la quotité de travail
-	CompanyWorkTimeReference          float64   `dsn:"S21.G00.40.012"` // Quotité de travail de référence de l'entreprise pour la catégorie de salarié
-	ContractWorkTime                  float64   `dsn:"S21.G00.40.013"` // Quotité de travail du contrat
-	WorkTimeArrangement               string    `dsn:"S21.G00.40.014"` // Modalité d'exercice du temps de travail
-	MandatorySchemeContribution       string    `dsn:"S21.G00.40.016"` // Complément de base au régime obligatoire
-	CollectiveAgreementCode           string    `dsn:"S21.G00.40.017"` // Code convention collective applicable
-	HealthInsuranceScheme             string    `dsn:"S21.G00.40.018"` // Code régime de base risque maladie
-	WorkplaceID                       string    `dsn:"S21.G00.40.019"` // Identifiant du lieu de travail
-	PensionScheme                     string    `dsn:"S21.G00.40.020"` // Code régime de base risque vieillesse
-	HiringReason                      string    `dsn:"S21.G00.40.021"` // Motif de recours
-	PaidLeaveScheme                   string    `dsn:"S21.G00.40.022"` // Code caisse professionnelle de congés payés
-	SpecificDeductionRate             float64   `dsn:"S21.G00.40.023"` // Taux de déduction forfaitaire spécifique pour frais professionnels
-	OverseasWorker                    string    `dsn:"S21.G00.40.024"` // Travailleur à l'étranger au sens du code de la Sécurité Sociale
-	DSNExclusionReason                string    `dsn:"S21.G00.40.025"` // Motif d'exclusion DSN
-	EmploymentStatus                  string    `dsn:"S21.G00.40.026"` // Statut d'emploi du salarié
-	UnemploymentInsuranceAssignment   string    `dsn:"S21.G00.40.027"` // Code affectation Assurance chômage
-	PublicEmployerInternalNumber      string    `dsn:"S21.G00.40.028"` // Numéro interne employeur public
-	UnemploymentInsuranceManagement   string    `dsn:"S21.G00.40.029"` // Type de gestion de l'Assurance chômage
-	AdhesionDate                      time.Time `dsn:"S21.G00.40.030"` // Date d'adhésion
-	TerminationDate                   time.Time `dsn:"S21.G00.40.031"` // Date de dénonciation
-	ManagementAgreementEffectiveDate  time.Time `dsn:"S21.G00.40.032"` // Date d'effet de la convention de gestion"
-	ManagementAgreementNumber         string    `dsn:"S21.G00.40.033"` // Numéro de convention de gestion
-	HealthInsuranceDelegateCode       string    `dsn:"S21.G00.40.035"` // Code délégataire du risque maladie
-	MultipleJobsCode                  string    `dsn:"S21.G00.40.036"` // Code emplois multiples
-	MultipleEmployersCode             string    `dsn:"S21.G00.40.037"` // Code employeurs multiples
-	WorkAccidentRiskScheme            string    `dsn:"S21.G00.40.039"` // Code régime de base risque accident du travail
-	WorkAccidentRiskCode              string    `dsn:"S21.G00.40.040"` // Code risque accident du travail
-	CollectiveAgreementPosition       string    `dsn:"S21.G00.40.041"` // Positionnement dans la convention collective
-	APECITACategoryCode               string    `dsn:"S21.G00.40.042"` // Code statut catégoriel APECITA
-	WorkAccidentContributionRate      float64   `dsn:"S21.G00.40.043"` // Taux de cotisation accident du travail
-	PartTimeFullTimeContribution      string    `dsn:"S21.G00.40.044"` // Salarié à temps partiel cotisant à temps plein
-	TipBasedRemuneration              string    `dsn:"S21.G00.40.045"` // Rémunération au pourboire
-	UserEstablishmentID               string    `dsn:"S21.G00.40.046"` // Identifiant de l'établissement utilisateur
-	LivePerformanceServiceProviderID  string    `dsn:"S21.G00.40.048"` // Numéro de label « Prestataire de services du spectacle vivant »
-	ShowBusinessLicenseNumber         string    `dsn:"S21.G00.40.049"` // Numéro de licence entrepreneur spectacle
-	ShowObjectNumber                  string    `dsn:"S21.G00.40.050"` // Numéro objet spectacle
-	ShowOrganizerStatus               string    `dsn:"S21.G00.40.051"` // Statut organisateur spectacle
-	StatePublicServicePCSESECode      string    `dsn:"S21.G00.40.052"` // [FP] Code complément PCS-ESE pour la fonction publique d'Etat (NNE)
-	PositionNature                    string    `dsn:"S21.G00.40.053"` // Nature du poste
-	FullTimeWorkReferenceQuota        float64   `dsn:"S21.G00.40.054"` // [FP] Quotité de travail de référence de l'entreprise pour la catégorie  salarié dans l'hypothèse d'un poste à temps complet"`
-	PartTimeWorkRate                  float64   `dsn:"S21.G00.40.055"` // Taux de travail à temps partiel
-	ServiceCategoryCode               string    `dsn:"S21.G00.40.056"` // Code catégorie de service
-	GrossIndex                        int       `dsn:"S21.G00.40.057"` // [FP] Indice brut
-	NetIndex                          int       `dsn:"S21.G00.40.058"` // [FP] Indice majoré
-	NewIndexBonus                     int       `dsn:"S21.G00.40.059"` // [FP] Nouvelle bonification indiciaire (NBI)
-	OriginalGrossIndex                int       `dsn:"S21.G00.40.060"` // [FP] Indice brut d'origine
-	Article15ContributionGrossIndex   int       `dsn:"S21.G00.40.061"` // [FP] Indice brut de cotisation dans un emploi supérieur (article 15)
-	FormerPublicEmployer              string    `dsn:"S21.G00.40.062"` // [FP] Ancien employeur public
-	FormerPublicEmployeeOriginalIndex int       `dsn:"S21.G00.40.063"` // [FP] Indice brut d'origine ancien salarié employeur public
-	FirefighterOriginalIndex          int       `dsn:"S21.G00.40.064"` // [FP] Indice brut d'origine sapeur-pompier professionnel (SPP)
-	ContractualOriginalSalary         string    `dsn:"S21.G00.40.065"` // [FP] Maintien du traitement d'origine d'un contractuel titulaire
-	SecondmentType                    string    `dsn:"S21.G00.40.066"` // [FP] Type de détachement
-	NavigationType                    string    `dsn:"S21.G00.40.067"` // Genre de navigation
-	ActiveServiceRate                 float64   `dsn:"S21.G00.40.068"` // Taux de service actif
-	RemunerationLevel                 string    `dsn:"S21.G00.40.069"` // Niveau de rémunération
-	PayGrade                          string    `dsn:"S21.G00.40.070"` // Echelon
-	HierarchicalCoefficient           float64   `dsn:"S21.G00.40.071"` // Coefficient hiérarchique
-	DisabledWorkerStatus              string    `dsn:"S21.G00.40.072"` // Statut BOETH
-	PublicPolicySchemeComplement      string    `dsn:"S21.G00.40.073"` // Complément de dispositif de politique publique
-	ExternalAssignmentCase            string    `dsn:"S21.G00.40.074"` // Cas de mise à disposition externe d'un individu de l'établissement
-	FinalClassificationCategory       string    `dsn:"S21.G00.40.075"` // Catégorie de classement finale
-	MaritimeEngagementContractID      string    `dsn:"S21.G00.40.076"` // Identifiant du contrat d'engagement maritime
-	CNIEGCollege                      string    `dsn:"S21.G00.40.077"` // Collège (CNIEG)
-	PartTimeWorkArrangement           string    `dsn:"S21.G00.40.078"` // Forme d'aménagement du temps de travail dans le cadre de l'activité partielle
-	Grade                             string    `dsn:"S21.G00.40.079"` // Grade
-	IndexSupplementaryTreatment       int       `dsn:"S21.G00.40.080"` // [FP] Indice complément de traitement indiciaire (CTI)
-	GeographicFINESS                  string    `dsn:"S21.G00.40.081"` // FINESS géographique
-}
-
-func GenerateContract() Contrat {
+	ContractStartDate                 time.Time `dsn:"S21.G00.40.001"`           // Date de début du contrat
+	EmployeeStatus                    string    `dsn:"S21.G00.40.002"`           // Statut du salarié (conventionnel)
+	MandatorySupplementaryPensionCode string    `dsn:"S21.G00.40.003"`           // Code statut catégoriel Retraite Complémentaire obligatoire
+	OccupationCode                    string    `dsn:"S21.G00.40.004"`           // Code profession et catégorie socioprofessionnelle (PCS-ESE)
+	OccupationCodeExtension           string    `dsn:"S21.G00.40.005"`           // Code complément PCS-ESE
+	JobTitle                          string    `dsn:"S21.G00.40.006"`           // Libellé de l'emploi
+	ContractType                      string    `dsn:"S21.G00.40.007"`           // Nature du contrat
+	PublicPolicyScheme                string    `dsn:"S21.G00.40.008"`           // Dispositif de politique publique et conventionnel
+	ContractNumber                    string    `dsn:"S21.G00.40.009"`           // Numéro du contrat
+	ExpectedEndDate                   time.Time `dsn:"S21.G00.40.010,omitempty"` // Date de fin prévisionnelle du contrat
+	WorkTimeUnit                      string    `dsn:"S21.G00.40.011"`           // Unité de mesure de la quotité de travail
+	CompanyWorkTimeReference          float64   `dsn:"S21.G00.40.012,quotity"`   // Quotité de travail de référence de l'entreprise pour la catégorie de salarié
+	ContractWorkTime                  float64   `dsn:"S21.G00.40.013,quotity"`   // Quotité de travail du contrat
+	WorkTimeArrangement               string    `dsn:"S21.G00.40.014"`           // Modalité d'exercice du temps de travail
+	MandatorySchemeContribution       string    `dsn:"S21.G00.40.016"`           // Complément de base au régime obligatoire
+	CollectiveAgreementCode           string    `dsn:"S21.G00.40.017"`           // Code convention collective applicable
+	HealthInsuranceScheme             string    `dsn:"S21.G00.40.018"`           // Code régime de base risque maladie
+	WorkplaceID                       string    `dsn:"S21.G00.40.019"`           // Identifiant du lieu de travail
+	PensionScheme                     string    `dsn:"S21.G00.40.020"`           // Code régime de base risque vieillesse
+	HiringReason                      string    `dsn:"S21.G00.40.021"`           // Motif de recours
+	PaidLeaveScheme                   string    `dsn:"S21.G00.40.022"`           // Code caisse professionnelle de congés payés
+	SpecificDeductionRate             float64   `dsn:"S21.G00.40.023,rate"`      // Taux de déduction forfaitaire spécifique pour frais professionnels
+	OverseasWorker                    string    `dsn:"S21.G00.40.024"`           // Travailleur à l'étranger au sens du code de la Sécurité Sociale
+	DSNExclusionReason                string    `dsn:"S21.G00.40.025"`           // Motif d'exclusion DSN
+	EmploymentStatus                  string    `dsn:"S21.G00.40.026"`           // Statut d'emploi du salarié
+	UnemploymentInsuranceAssignment   string    `dsn:"S21.G00.40.027"`           // Code affectation Assurance chômage
+	PublicEmployerInternalNumber      string    `dsn:"S21.G00.40.028"`           // Numéro interne employeur public
+	UnemploymentInsuranceManagement   string    `dsn:"S21.G00.40.029"`           // Type de gestion de l'Assurance chômage
+	AdhesionDate                      time.Time `dsn:"S21.G00.40.030"`           // Date d'adhésion
+	TerminationDate                   time.Time `dsn:"S21.G00.40.031"`           // Date de dénonciation
+	ManagementAgreementEffectiveDate  time.Time `dsn:"S21.G00.40.032"`           // Date d'effet de la convention de gestion"
+	ManagementAgreementNumber         string    `dsn:"S21.G00.40.033"`           // Numéro de convention de gestion
+	HealthInsuranceDelegateCode       string    `dsn:"S21.G00.40.035"`           // Code délégataire du risque maladie
+	MultipleJobsCode                  string    `dsn:"S21.G00.40.036"`           // Code emplois multiples
+	MultipleEmployersCode             string    `dsn:"S21.G00.40.037"`           // Code employeurs multiples
+	WorkAccidentRiskScheme            string    `dsn:"S21.G00.40.039"`           // Code régime de base risque accident du travail
+	WorkAccidentRiskCode              string    `dsn:"S21.G00.40.040"`           // Code risque accident du travail
+	CollectiveAgreementPosition       string    `dsn:"S21.G00.40.041"`           // Positionnement dans la convention collective
+	APECITACategoryCode               string    `dsn:"S21.G00.40.042"`           // Code statut catégoriel APECITA
+	WorkAccidentContributionRate      float64   `dsn:"S21.G00.40.043,rate"`      // Taux de cotisation accident du travail
+	PartTimeFullTimeContribution      string    `dsn:"S21.G00.40.044"`           // Salarié à temps partiel cotisant à temps plein
+	TipBasedRemuneration              string    `dsn:"S21.G00.40.045"`           // Rémunération au pourboire
+	UserEstablishmentID               string    `dsn:"S21.G00.40.046,omitempty"` // Identifiant de l'établissement utilisateur
+	LivePerformanceServiceProviderID  string    `dsn:"S21.G00.40.048"`           // Numéro de label « Prestataire de services du spectacle vivant »
+	ShowBusinessLicenseNumber         string    `dsn:"S21.G00.40.049"`           // Numéro de licence entrepreneur spectacle
+	ShowObjectNumber                  string    `dsn:"S21.G00.40.050"`           // Numéro objet spectacle
+	ShowOrganizerStatus               string    `dsn:"S21.G00.40.051"`           // Statut organisateur spectacle
+	StatePublicServicePCSESECode      string    `dsn:"S21.G00.40.052"`           // [FP] Code complément PCS-ESE pour la fonction publique d'Etat (NNE)
+	PositionNature                    string    `dsn:"S21.G00.40.053"`           // Nature du poste
+	FullTimeWorkReferenceQuota        float64   `dsn:"S21.G00.40.054,quotity"`   // [FP] Quotité de travail de référence de l'entreprise pour la catégorie  salarié dans l'hypothèse d'un poste à temps complet"`
+	PartTimeWorkRate                  float64   `dsn:"S21.G00.40.055,rate"`      // Taux de travail à temps partiel
+	ServiceCategoryCode               string    `dsn:"S21.G00.40.056"`           // Code catégorie de service
+	GrossIndex                        int       `dsn:"S21.G00.40.057"`           // [FP] Indice brut
+	NetIndex                          int       `dsn:"S21.G00.40.058"`           // [FP] Indice majoré
+	NewIndexBonus                     int       `dsn:"S21.G00.40.059"`           // [FP] Nouvelle bonification indiciaire (NBI)
+	OriginalGrossIndex                int       `dsn:"S21.G00.40.060"`           // [FP] Indice brut d'origine
+	Article15ContributionGrossIndex   int       `dsn:"S21.G00.40.061"`           // [FP] Indice brut de cotisation dans un emploi supérieur (article 15)
+	FormerPublicEmployer              string    `dsn:"S21.G00.40.062"`           // [FP] Ancien employeur public
+	FormerPublicEmployeeOriginalIndex int       `dsn:"S21.G00.40.063"`           // [FP] Indice brut d'origine ancien salarié employeur public
+	FirefighterOriginalIndex          int       `dsn:"S21.G00.40.064"`           // [FP] Indice brut d'origine sapeur-pompier professionnel (SPP)
+	ContractualOriginalSalary         string    `dsn:"S21.G00.40.065"`           // [FP] Maintien du traitement d'origine d'un contractuel titulaire
+	SecondmentType                    string    `dsn:"S21.G00.40.066"`           // [FP] Type de détachement
+	NavigationType                    string    `dsn:"S21.G00.40.067"`           // Genre de navigation
+	ActiveServiceRate                 float64   `dsn:"S21.G00.40.068,rate"`      // Taux de service actif
+	RemunerationLevel                 string    `dsn:"S21.G00.40.069"`           // Niveau de rémunération
+	PayGrade                          string    `dsn:"S21.G00.40.070"`           // Echelon
+	HierarchicalCoefficient           float64   `dsn:"S21.G00.40.071,integer"`   // Coefficient hiérarchique
+	DisabledWorkerStatus              string    `dsn:"S21.G00.40.072,omitempty"` // Statut BOETH
+	PublicPolicySchemeComplement      string    `dsn:"S21.G00.40.073"`           // Complément de dispositif de politique publique
+	ExternalAssignmentCase            string    `dsn:"S21.G00.40.074"`           // Cas de mise à disposition externe d'un individu de l'établissement
+	FinalClassificationCategory       string    `dsn:"S21.G00.40.075"`           // Catégorie de classement finale
+	MaritimeEngagementContractID      string    `dsn:"S21.G00.40.076"`           // Identifiant du contrat d'engagement maritime
+	CNIEGCollege                      string    `dsn:"S21.G00.40.077"`           // Collège (CNIEG)
+	PartTimeWorkArrangement           string    `dsn:"S21.G00.40.078"`           // Forme d'aménagement du temps de travail dans le cadre de l'activité partielle
+	Grade                             string    `dsn:"S21.G00.40.079"`           // Grade
+	IndexSupplementaryTreatment       int       `dsn:"S21.G00.40.080"`           // [FP] Indice complément de traitement indiciaire (CTI)
+	GeographicFINESS                  string    `dsn:"S21.G00.40.081"`           // FINESS géographique
+
+	WorkStoppages        []WorkStoppage        `dsn:"S21.G00.60"` // Arrêt de travail
+	Ends                 []ContractEnd         `dsn:"S21.G00.62"` // Fin de contrat
+	Suspensions          []Suspension          `dsn:"S21.G00.65"` // Autre suspension de l'exécution du contrat
+	TherapeuticPartTimes []TherapeuticPartTime `dsn:"S21.G00.66"` // Temps partiel thérapeutique
+}
+
+const (
+	ContractPermanent             = "01" // Contrat de travail à durée indéterminée de droit privé
+	ContractFixedTerm             = "02" // Contrat de travail à durée déterminée de droit privé
+	ContractTemporaryWork         = "03" // Contrat de mission (contrat de travail temporaire)
+	ContractPermanentIntermittent = "07" // Contrat à durée indéterminée intermittente
+	ContractPublicPermanent       = "09" // Contrat à durée indéterminée de droit public
+	ContractPublicFixedTerm       = "10" // Contrat à durée déterminée de droit public
+	ContractInternship            = "29" // Convention de stage (hors formation professionnelle)
+)
+
+// isFixedTerm tells whether a contract of type contractType has an expected
+// end date
+func isFixedTerm(contractType string) bool {
+	switch contractType {
+	case ContractFixedTerm, ContractTemporaryWork, ContractPublicFixedTerm, ContractInternship:
+		return true
+	}
+	return false
+}
+
+// GenerateContract creates a new Contrat started before the end of the declared
+// month, at the workplace of SIRET workplaceID. Fixed-term contracts end 1 to
+// 18 months after their start. The user establishment of a temporary work
+// contract is left to the caller.
+func GenerateContract(month time.Time, workplaceID string) Contrat {
+	startDate := randomDay(firstDayOfMonth(month).AddDate(-15, 0, 0), lastDayOfMonth(month).AddDate(0, 0, -1))
+	contractType := drawCode("S21.G00.40.007")
+	if chance(temporaryWorkShare) {
+		contractType = ContractTemporaryWork
+	}
+	var expectedEndDate time.Time
+	if isFixedTerm(contractType) {
+		expectedEndDate = startDate.AddDate(0, gofakeit.IntRange(1, 18), 0)
+	}
+
 	return Contrat{
-		ContractStartDate:                 gofakeit.Date(),
-		EmployeeStatus:                    gofakeit.Letter(),
-		MandatorySupplementaryPensionCode: gofakeit.LetterN(2),
-		OccupationCode:                    gofakeit.DigitN(4),
-		OccupationCodeExtension:           gofakeit.DigitN(2),
+		ContractStartDate:                 startDate,
+		EmployeeStatus:                    drawCode("S21.G00.40.002"),
+		MandatorySupplementaryPensionCode: drawCode("S21.G00.40.003"),
+		OccupationCode:                    drawCode("S21.G00.40.004"),
+		OccupationCodeExtension:           drawCode("S21.G00.40.005"),
 		JobTitle:                          gofakeit.JobTitle(),
-		ContractType:                      gofakeit.DigitN(2),
-		PublicPolicyScheme:                gofakeit.DigitN(2),
+		ContractType:                      contractType,
+		PublicPolicyScheme:                drawCode("S21.G00.40.008"),
 		ContractNumber:                    gofakeit.DigitN(5),
-		ExpectedEndDate:                   gofakeit.Date(),
-		WorkTimeUnit:                      gofakeit.DigitN(2),
+		ExpectedEndDate:                   expectedEndDate,
+		WorkTimeUnit:                      drawCode("S21.G00.40.011"),
 		CompanyWorkTimeReference:          gofakeit.Float64Range(0, 100),
 		ContractWorkTime:                  gofakeit.Float64Range(0, 100),
-		WorkTimeArrangement:               gofakeit.DigitN(2),
-		MandatorySchemeContribution:       gofakeit.DigitN(2),
-		CollectiveAgreementCode:           gofakeit.DigitN(4),
-		HealthInsuranceScheme:             gofakeit.DigitN(3),
-		WorkplaceID:                       gofakeit.UUID(),
-		PensionScheme:                     gofakeit.DigitN(3),
-		HiringReason:                      gofakeit.DigitN(2),
-		PaidLeaveScheme:                   gofakeit.DigitN(2),
+		WorkTimeArrangement:               drawCode("S21.G00.40.014"),
+		MandatorySchemeContribution:       drawCode("S21.G00.40.016"),
+		CollectiveAgreementCode:           drawCode("S21.G00.40.017"),
+		HealthInsuranceScheme:             drawCode("S21.G00.40.018"),
+		WorkplaceID:                       workplaceID,
+		PensionScheme:                     drawCode("S21.G00.40.020"),
+		HiringReason:                      drawCode("S21.G00.40.021"),
+		PaidLeaveScheme:                   drawCode("S21.G00.40.022"),
 		SpecificDeductionRate:             gofakeit.Float64Range(0, 100),
-		OverseasWorker:                    gofakeit.DigitN(2),
-		DSNExclusionReason:                gofakeit.DigitN(2),
-		EmploymentStatus:                  gofakeit.DigitN(2),
-		UnemploymentInsuranceAssignment:   gofakeit.DigitN(2),
+		OverseasWorker:                    drawCode("S21.G00.40.024"),
+		DSNExclusionReason:                drawCode("S21.G00.40.025"),
+		EmploymentStatus:                  drawCode("S21.G00.40.026"),
+		UnemploymentInsuranceAssignment:   drawCode("S21.G00.40.027"),
 		PublicEmployerInternalNumber:      gofakeit.DigitN(10),
-		UnemploymentInsuranceManagement:   gofakeit.DigitN(2),
+		UnemploymentInsuranceManagement:   drawCode("S21.G00.40.029"),
 		AdhesionDate:                      gofakeit.Date(),
 		TerminationDate:                   gofakeit.Date(),
 		ManagementAgreementEffectiveDate:  gofakeit.Date(),
 		ManagementAgreementNumber:         gofakeit.DigitN(10),
 		HealthInsuranceDelegateCode:       gofakeit.DigitN(3),
-		MultipleJobsCode:                  gofakeit.DigitN(2),
-		MultipleEmployersCode:             gofakeit.DigitN(2),
-		WorkAccidentRiskScheme:            gofakeit.DigitN(3),
+		MultipleJobsCode:                  drawCode("S21.G00.40.036"),
+		MultipleEmployersCode:             drawCode("S21.G00.40.037"),
+		WorkAccidentRiskScheme:            drawCode("S21.G00.40.039"),
 		WorkAccidentRiskCode:              gofakeit.DigitN(6),
 		CollectiveAgreementPosition:       gofakeit.DigitN(4),
-		APECITACategoryCode:               gofakeit.DigitN(2),
+		APECITACategoryCode:               drawCode("S21.G00.40.042"),
 		WorkAccidentContributionRate:      gofakeit.Float64Range(0, 100),
-		PartTimeFullTimeContribution:      gofakeit.DigitN(2),
-		TipBasedRemuneration:              gofakeit.DigitN(2),
-		UserEstablishmentID:               gofakeit.UUID(),
+		PartTimeFullTimeContribution:      drawCode("S21.G00.40.044"),
+		TipBasedRemuneration:              drawCode("S21.G00.40.045"),
 		LivePerformanceServiceProviderID:  gofakeit.DigitN(10),
 		ShowBusinessLicenseNumber:         gofakeit.DigitN(10),
 		ShowObjectNumber:                  gofakeit.DigitN(10),
-		ShowOrganizerStatus:               gofakeit.DigitN(2),
-		StatePublicServicePCSESECode:      gofakeit.DigitN(4),
-		PositionNature:                    gofakeit.DigitN(2),
+		ShowOrganizerStatus:               drawCode("S21.G00.40.051"),
+		StatePublicServicePCSESECode:      drawCode("S21.G00.40.052"),
+		PositionNature:                    drawCode("S21.G00.40.053"),
 		FullTimeWorkReferenceQuota:        gofakeit.Float64Range(0, 100),
 		PartTimeWorkRate:                  gofakeit.Float64Range(0, 100),
-		ServiceCategoryCode:               gofakeit.DigitN(2),
+		ServiceCategoryCode:               drawCode("S21.G00.40.056"),
 		GrossIndex:                        gofakeit.IntRange(100, 1000),
 		NetIndex:                          gofakeit.IntRange(100, 1000),
 		NewIndexBonus:                     gofakeit.IntRange(0, 100),
 		OriginalGrossIndex:                gofakeit.IntRange(100, 1000),
 		Article15ContributionGrossIndex:   gofakeit.IntRange(100, 1000),
-		FormerPublicEmployer:              gofakeit.DigitN(2),
+		FormerPublicEmployer:              drawCode("S21.G00.40.062"),
 		FormerPublicEmployeeOriginalIndex: gofakeit.IntRange(100, 1000),
 		FirefighterOriginalIndex:          gofakeit.IntRange(100, 1000),
-		ContractualOriginalSalary:         gofakeit.DigitN(2),
-		SecondmentType:                    gofakeit.DigitN(2),
-		NavigationType:                    gofakeit.DigitN(2),
+		ContractualOriginalSalary:         drawCode("S21.G00.40.065"),
+		SecondmentType:                    drawCode("S21.G00.40.066"),
+		NavigationType:                    drawCode("S21.G00.40.067"),
 		ActiveServiceRate:                 gofakeit.Float64Range(0, 100),
 		RemunerationLevel:                 gofakeit.DigitN(2),
 		PayGrade:                          gofakeit.DigitN(2),
-		HierarchicalCoefficient:           gofakeit.Float64Range(1, 10),
-		DisabledWorkerStatus:              gofakeit.DigitN(2),
-		PublicPolicySchemeComplement:      gofakeit.DigitN(2),
-		ExternalAssignmentCase:            gofakeit.DigitN(2),
-		FinalClassificationCategory:       gofakeit.DigitN(2),
+		HierarchicalCoefficient:           float64(gofakeit.IntRange(1, 10)),
+		DisabledWorkerStatus:              "", // Drawn for the whole establishment, see generateDisabledWorkerStatuses
+		PublicPolicySchemeComplement:      drawCode("S21.G00.40.073"),
+		ExternalAssignmentCase:            drawCode("S21.G00.40.074"),
+		FinalClassificationCategory:       drawCode("S21.G00.40.075"),
 		MaritimeEngagementContractID:      gofakeit.UUID(),
-		CNIEGCollege:                      gofakeit.DigitN(2),
-		PartTimeWorkArrangement:           gofakeit.DigitN(2),
+		CNIEGCollege:                      drawCode("S21.G00.40.077"),
+		PartTimeWorkArrangement:           drawCode("S21.G00.40.078"),
 		Grade:                             gofakeit.LetterN(3),
 		IndexSupplementaryTreatment:       gofakeit.IntRange(0, 100),
 		GeographicFINESS:                  gofakeit.DigitN(9),
@@ -506,18 +653,18 @@ func GenerateContract() Contrat {
 }
 
 type Payment struct {
-	PaymentDate                   time.Time `dsn:"S21.G00.50.001"` // Date de versement
-	TaxableNetRemuneration        float64   `dsn:"S21.G00.50.002"` // Rémunération nette fiscale
-	PaymentNumber                 string    `dsn:"S21.G00.50.003"` // Numéro de versement
-	NetAmountPaid                 float64   `dsn:"S21.G00.50.004"` // Montant net versé
-	WithholdingTaxRate            float64   `dsn:"S21.G00.50.006"` // Taux de prélèvement à la source
-	WithholdingTaxRateType        string    `dsn:"S21.G00.50.007"` // Type du taux de prélèvement à la source
-	WithholdingTaxRateID          string    `dsn:"S21.G00.50.008"` // Identifiant du taux de prélèvement à la source
-	WithholdingTaxAmount          float64   `dsn:"S21.G00.50.009"` // Montant de prélèvement à la source
-	NonTaxableIncomeAmount        float64   `dsn:"S21.G00.50.011"` // Montant de la part non imposable du revenu
-	TaxBaseDeductionAmount        float64   `dsn:"S21.G00.50.012"` // Montant de l'abattement sur la base fiscale (non déduit de la rémunération nette fiscale)
-	AmountSubjectToWithholdingTax float64   `dsn:"S21.G00.50.013"` // Montant soumis au PAS
-	MonthlyDSNReferenceMonth      string    `dsn:"S21.G00.50.020"` // Mois de la DSN mensuelle de rattachement des éléments déclarés dans le FCTU
+	PaymentDate                   time.Time `dsn:"S21.G00.50.001"`        // Date de versement
+	TaxableNetRemuneration        float64   `dsn:"S21.G00.50.002,amount"` // Rémunération nette fiscale
+	PaymentNumber                 string    `dsn:"S21.G00.50.003"`        // Numéro de versement
+	NetAmountPaid                 float64   `dsn:"S21.G00.50.004,amount"` // Montant net versé
+	WithholdingTaxRate            float64   `dsn:"S21.G00.50.006,rate"`   // Taux de prélèvement à la source
+	WithholdingTaxRateType        string    `dsn:"S21.G00.50.007"`        // Type du taux de prélèvement à la source
+	WithholdingTaxRateID          string    `dsn:"S21.G00.50.008"`        // Identifiant du taux de prélèvement à la source
+	WithholdingTaxAmount          float64   `dsn:"S21.G00.50.009,amount"` // Montant de prélèvement à la source
+	NonTaxableIncomeAmount        float64   `dsn:"S21.G00.50.011,amount"` // Montant de la part non imposable du revenu
+	TaxBaseDeductionAmount        float64   `dsn:"S21.G00.50.012,amount"` // Montant de l'abattement sur la base fiscale (non déduit de la rémunération nette fiscale)
+	AmountSubjectToWithholdingTax float64   `dsn:"S21.G00.50.013,amount"` // Montant soumis au PAS
+	MonthlyDSNReferenceMonth      string    `dsn:"S21.G00.50.020"`        // Mois de la DSN mensuelle de rattachement des éléments déclarés dans le FCTU
 }
 
 func GeneratePayment() Payment {
@@ -525,47 +672,81 @@ func GeneratePayment() Payment {
 
 	return Payment{
 		PaymentDate:                   paymentDate,
-		TaxableNetRemuneration:        gofakeit.Float64Range(1000, 10000),
+		TaxableNetRemuneration:        roundAmount(gofakeit.Float64Range(1000, 10000)),
 		PaymentNumber:                 gofakeit.DigitN(5),
-		NetAmountPaid:                 gofakeit.Float64Range(1000, 10000),
+		NetAmountPaid:                 roundAmount(gofakeit.Float64Range(1000, 10000)),
 		WithholdingTaxRate:            gofakeit.Float64Range(0, 100),
-		WithholdingTaxRateType:        gofakeit.LetterN(2),
+		WithholdingTaxRateType:        drawCode("S21.G00.50.007"),
 		WithholdingTaxRateID:          gofakeit.UUID(),
-		WithholdingTaxAmount:          gofakeit.Float64Range(0, 1000),
-		NonTaxableIncomeAmount:        gofakeit.Float64Range(0, 1000),
-		TaxBaseDeductionAmount:        gofakeit.Float64Range(0, 1000),
-		AmountSubjectToWithholdingTax: gofakeit.Float64Range(1000, 10000),
+		WithholdingTaxAmount:          roundAmount(gofakeit.Float64Range(0, 1000)),
+		NonTaxableIncomeAmount:        roundAmount(gofakeit.Float64Range(0, 1000)),
+		TaxBaseDeductionAmount:        roundAmount(gofakeit.Float64Range(0, 1000)),
+		AmountSubjectToWithholdingTax: roundAmount(gofakeit.Float64Range(1000, 10000)),
 		MonthlyDSNReferenceMonth:      gofakeit.Date().Format("2006-01"),
 	}
 }
 
-type Remuneration struct {
-	PayPeriodStartDate             time.Time `dsn:"S21.G00.51.001"` // Date de début de période de paie
-	PayPeriodEndDate               time.Time `dsn:"S21.G00.51.002"` // Date de fin de période de paie
-	ContractNumber                 string    `dsn:"S21.G00.51.010"` // Numéro du contrat
-	Type                           string    `dsn:"S21.G00.51.011"` // Type
-	NumberOfHours                  int64     `dsn:"S21.G00.51.012"` // Nombre d'heures
-	Amount                         float64   `dsn:"S21.G00.51.013"` // Montant
-	AdministrativeStatusPayRate    float64   `dsn:"S21.G00.51.014"` // [FP] Taux de rémunération de la situation administrative
-	NuclearPowerPlantOperationRate float64   `dsn:"S21.G00.51.015"` // Taux de conduite centrale nucléaire
-	IncreasedRate                  float64   `dsn:"S21.G00.51.016"` // Taux de majoration
-	ContributedRemunerationRate    float64   `dsn:"S21.G00.51.019"` // Taux de rémunération cotisée
-	FormerApprenticeIncreaseRate   float64   `dsn:"S21.G00.51.020"` // Taux de majoration ex-apprenti/ex-élève
+// Garnishment represents an amount withheld from a payment on behalf of the
+// tax administration
+// French: Saisie administrative à tiers détenteur
+type Garnishment struct {
+	ID     string  `dsn:"S21.G00.98.001"`        // Identifiant de la SATD
+	Status string  `dsn:"S21.G00.98.002"`        // Etat de prise en compte de la SATD
+	Amount float64 `dsn:"S21.G00.98.003,amount"` // Montant
+}
+
+const (
+	GarnishmentApplied = "01" // Prise en compte
+)
+
+// GenerateGarnishment creates a new Garnishment withholding a share of the net
+// amount of a payment, within the seizable part of the salary
+func GenerateGarnishment(payment Payment, month time.Time) Garnishment {
+	return Garnishment{
+		ID:     generateGarnishmentID(month),
+		Status: GarnishmentApplied,
+		Amount: roundAmount(payment.NetAmountPaid * gofakeit.Float64Range(0.05, 0.2)),
+	}
+}
+
+// ApplyGarnishment deducts the amount withheld by a Garnishment from the net
+// amount of a Payment
+func ApplyGarnishment(payment Payment, garnishment Garnishment) Payment {
+	payment.NetAmountPaid -= garnishment.Amount
+	return payment
+}
+
+func generateGarnishmentID(month time.Time) string {
+	// SATD identifier format: year of issue + 11 digits
+	return fmt.Sprintf("%d%s", month.Year(), gofakeit.DigitN(11))
 }
 
-func GenerateRemuneration(contractNumber string) Remuneration {
-	startDate := gofakeit.DateRange(time.Now().AddDate(0, -1, 0), time.Now())
-	endDate := gofakeit.DateRange(startDate, startDate.AddDate(0, 1, 0))
+type Remuneration struct {
+	PayPeriodStartDate             time.Time `dsn:"S21.G00.51.001"`        // Date de début de période de paie
+	PayPeriodEndDate               time.Time `dsn:"S21.G00.51.002"`        // Date de fin de période de paie
+	ContractNumber                 string    `dsn:"S21.G00.51.010"`        // Numéro du contrat
+	Type                           string    `dsn:"S21.G00.51.011"`        // Type
+	NumberOfHours                  int64     `dsn:"S21.G00.51.012"`        // Nombre d'heures
+	Amount                         float64   `dsn:"S21.G00.51.013,amount"` // Montant
+	AdministrativeStatusPayRate    float64   `dsn:"S21.G00.51.014,rate"`   // [FP] Taux de rémunération de la situation administrative
+	NuclearPowerPlantOperationRate float64   `dsn:"S21.G00.51.015,rate"`   // Taux de conduite centrale nucléaire
+	IncreasedRate                  float64   `dsn:"S21.G00.51.016,rate"`   // Taux de majoration
+	ContributedRemunerationRate    float64   `dsn:"S21.G00.51.019,rate"`   // Taux de rémunération cotisée
+	FormerApprenticeIncreaseRate   float64   `dsn:"S21.G00.51.020,rate"`   // Taux de majoration ex-apprenti/ex-élève
+}
 
-	remunerations := []string{"012", "013", "017", "018"}
+// GenerateRemuneration creates a new Remuneration over the declared month
+func GenerateRemuneration(contractNumber string, month time.Time) Remuneration {
+	startDate := firstDayOfMonth(month)
+	endDate := lastDayOfMonth(month)
 
 	return Remuneration{
 		PayPeriodStartDate:             startDate,
 		PayPeriodEndDate:               endDate,
 		ContractNumber:                 contractNumber,
-		Type:                           sample(remunerations),
+		Type:                           drawCode("S21.G00.51.011"),
 		NumberOfHours:                  int64(gofakeit.IntRange(0, 200)),
-		Amount:                         gofakeit.Float64Range(1000, 10000),
+		Amount:                         roundAmount(gofakeit.Float64Range(1000, 10000)),
 		AdministrativeStatusPayRate:    gofakeit.Float64Range(0, 100),
 		NuclearPowerPlantOperationRate: gofakeit.Float64Range(0, 100),
 		IncreasedRate:                  gofakeit.Float64Range(0, 100),
@@ -574,28 +755,1153 @@ func GenerateRemuneration(contractNumber string) Remuneration {
 	}
 }
 
+// ProrateRemuneration restricts the Remuneration of a full month to the days
+// between start and end, when the contract starts or ends during the month
+func ProrateRemuneration(remuneration Remuneration, start, end time.Time) Remuneration {
+	monthDays := workingDaysBetween(remuneration.PayPeriodStartDate, remuneration.PayPeriodEndDate)
+	start = maxDate(start, remuneration.PayPeriodStartDate)
+	end = minDate(end, remuneration.PayPeriodEndDate)
+	ratio := float64(workingDaysBetween(start, end)) / float64(monthDays)
+
+	remuneration.PayPeriodStartDate = start
+	remuneration.PayPeriodEndDate = end
+	remuneration.Amount = roundAmount(remuneration.Amount * ratio)
+	remuneration.NumberOfHours = int64(math.Round(float64(remuneration.NumberOfHours) * ratio))
+	return remuneration
+}
+
+// DeductAbsence removes from the Remuneration the pay of the working days of
+// an unpaid absence from start to end
+func DeductAbsence(remuneration Remuneration, start, end time.Time) Remuneration {
+	periodDays := workingDaysBetween(remuneration.PayPeriodStartDate, remuneration.PayPeriodEndDate)
+	absentDays := workingDaysBetween(maxDate(start, remuneration.PayPeriodStartDate), minDate(end, remuneration.PayPeriodEndDate))
+	if periodDays == 0 || absentDays == 0 {
+		return remuneration
+	}
+	ratio := float64(periodDays-absentDays) / float64(periodDays)
+
+	remuneration.Amount = roundAmount(remuneration.Amount * ratio)
+	remuneration.NumberOfHours = int64(math.Round(float64(remuneration.NumberOfHours) * ratio))
+	return remuneration
+}
+
+// Bonus represents a bonus, gratification or indemnity paid with the salary
+// French: Prime, gratification et indemnité
+type Bonus struct {
+	Type                string     `dsn:"S21.G00.52.001"`           // Type
+	Amount              float64    `dsn:"S21.G00.52.002,amount"`    // Montant
+	PeriodStartDate     *time.Time `dsn:"S21.G00.52.003,omitempty"` // Date de début de la période de rattachement
+	PeriodEndDate       *time.Time `dsn:"S21.G00.52.004,omitempty"` // Date de fin de la période de rattachement
+	ContractNumber      string     `dsn:"S21.G00.52.006"`           // Numéro du contrat
+	OriginalPaymentDate *time.Time `dsn:"S21.G00.52.007,omitempty"` // Date de versement d'origine
+}
+
+const (
+	IndemnityPaidLeave          = "014" // Indemnité compensatrice de congés payés
+	IndemnityNotice             = "015" // Indemnité compensatrice de préavis
+	IndemnityDismissal          = "016" // Indemnité légale ou conventionnelle de licenciement
+	IndemnityConventionalBreach = "018" // Indemnité spécifique de rupture conventionnelle
+)
+
+// GenerateTerminationIndemnities creates the indemnities paid at the end of a
+// contract, computed from the monthly salary of the employee
+func GenerateTerminationIndemnities(contract Contrat, end ContractEnd, notice *Notice, monthlySalary float64) []Bonus {
+	newBonus := func(kind string, amount float64) Bonus {
+		return Bonus{
+			Type:           kind,
+			Amount:         roundAmount(amount),
+			ContractNumber: contract.ContractNumber,
+		}
+	}
+
+	// Remaining paid leave days are paid back, on the basis of 22 working days per month
+	leaveDays := gofakeit.IntRange(0, 25)
+	bonuses := []Bonus{newBonus(IndemnityPaidLeave, monthlySalary*float64(leaveDays)/22)}
+
+	if notice != nil && notice.Type == NoticeNotWorkedPaid && end.LastDayPaid.Before(end.EndDate) {
+		months := float64(workingDaysBetween(end.LastDayPaid.AddDate(0, 0, 1), notice.EndDate)) / 22
+		bonuses = append(bonuses, newBonus(IndemnityNotice, monthlySalary*months))
+	}
+
+	// The legal indemnity is a quarter of a month of salary per year of
+	// seniority, after 8 months in the company
+	seniority := end.EndDate.Sub(contract.ContractStartDate).Hours() / 24 / 365.25
+	if seniority >= 8.0/12 {
+		switch end.Reason {
+		case EndDismissalEconomic, EndDismissalOther:
+			bonuses = append(bonuses, newBonus(IndemnityDismissal, monthlySalary*seniority/4))
+		case EndConventionalBreach:
+			bonuses = append(bonuses, newBonus(IndemnityConventionalBreach, monthlySalary*seniority/4))
+		}
+	}
+
+	return bonuses
+}
+
 type Activity struct {
-	Type            string  `dsn:"S21.G00.53.001"` // Type
-	Measure         float64 `dsn:"S21.G00.53.002"` // Mesure
-	MeasurementUnit string  `dsn:"S21.G00.53.003"` // Unité de mesure
+	Type            string  `dsn:"S21.G00.53.001"`         // Type
+	Measure         float64 `dsn:"S21.G00.53.002,quotity"` // Mesure
+	MeasurementUnit string  `dsn:"S21.G00.53.003"`         // Unité de mesure
 }
 
 func GenerateActivity() Activity {
-	activityTypes := []string{"01"}
 	return Activity{
-		Type:            sample(activityTypes),
+		Type:            drawCode("S21.G00.53.001"),
 		Measure:         gofakeit.Float64Range(0, 1000),
-		MeasurementUnit: gofakeit.DigitN(2),
+		MeasurementUnit: drawCode("S21.G00.53.003"),
+	}
+}
+
+const (
+	ActivityPaidWork      = "01" // Travail rémunéré
+	ActivityUnpaidAbsence = "02" // Absence non rémunérée
+
+	MeasurementUnitHour = "10" // Heure
+)
+
+// GenerateAbsenceActivity creates the Activity holding the hours lost to an
+// absence from start to end during the declared month. ok is false when the
+// absence does not overlap any working day of the month.
+func GenerateAbsenceActivity(start, end time.Time, month time.Time) (activity Activity, ok bool) {
+	days := workingDaysBetween(maxDate(start, firstDayOfMonth(month)), minDate(end, lastDayOfMonth(month)))
+	if days == 0 {
+		return Activity{}, false
 	}
+
+	return Activity{
+		Type:            ActivityUnpaidAbsence,
+		Measure:         float64(days) * hoursPerWorkingDay,
+		MeasurementUnit: MeasurementUnitHour,
+	}, true
+}
+
+// WorkStoppage represents a sick leave, maternity leave or work accident
+// French: Arrêt de travail
+type WorkStoppage struct {
+	Reason               string     `dsn:"S21.G00.60.001"`                 // Motif de l'arrêt
+	LastDayWorked        time.Time  `dsn:"S21.G00.60.002"`                 // Date du dernier jour travaillé
+	ExpectedEndDate      time.Time  `dsn:"S21.G00.60.003"`                 // Date de fin prévisionnelle
+	Subrogation          string     `dsn:"S21.G00.60.004"`                 // Subrogation
+	SubrogationStartDate *time.Time `dsn:"S21.G00.60.005,omitempty"`       // Date de début de subrogation
+	SubrogationEndDate   *time.Time `dsn:"S21.G00.60.006,omitempty"`       // Date de fin de subrogation
+	IBAN                 string     `dsn:"S21.G00.60.007,upper,omitempty"` // IBAN
+	BIC                  string     `dsn:"S21.G00.60.008,upper,omitempty"` // BIC
+	ResumptionDate       *time.Time `dsn:"S21.G00.60.010,omitempty"`       // Date de la reprise
+	ResumptionReason     string     `dsn:"S21.G00.60.011,omitempty"`       // Motif de la reprise
+	AccidentDate         *time.Time `dsn:"S21.G00.60.012,omitempty"`       // Date de l'accident ou de la première constatation
+}
+
+const (
+	StoppageSickness          = "01" // Maladie
+	StoppageMaternity         = "02" // Maternité
+	StoppagePaternity         = "03" // Paternité / accueil de l'enfant
+	StoppageCommutingAccident = "04" // Congé suite à un accident de trajet
+	StoppageOccupationalIll   = "05" // Congé suite à maladie professionnelle
+	StoppageWorkAccident      = "06" // Congé suite à accident de travail ou de service
+)
+
+const (
+	Yes = "01"
+	No  = "02"
+)
+
+// GenerateWorkStoppage creates a new WorkStoppage of the contract overlapping
+// the declared month
+func GenerateWorkStoppage(contract Contrat, month time.Time, gender string) WorkStoppage {
+	reasons := GetNomenclature("S21.G00.60.001").Except(StoppagePaternity)
+	if gender != Female {
+		reasons = GetNomenclature("S21.G00.60.001").Except(StoppageMaternity)
+	}
+	reason := reasons.Draw()
+
+	monthStart := firstDayOfMonth(month)
+	monthEnd := lastDayOfMonth(month)
+
+	// The stoppage starts at the latest on the last day of the month, and
+	// may have started in a previous month, but not before the contract
+	lastDayWorked := randomDay(maxDate(contract.ContractStartDate, monthStart.AddDate(0, -2, 0)), monthEnd.AddDate(0, 0, -1))
+	var expectedEndDate time.Time
+	switch reason {
+	case StoppageMaternity:
+		expectedEndDate = lastDayWorked.AddDate(0, 0, 112)
+	case StoppagePaternity:
+		expectedEndDate = lastDayWorked.AddDate(0, 0, 25)
+	default:
+		expectedEndDate = lastDayWorked.AddDate(0, 0, gofakeit.IntRange(3, 90))
+	}
+	// The stoppage must still be running at the beginning of the month
+	if expectedEndDate.Before(monthStart) {
+		expectedEndDate = monthStart.AddDate(0, 0, gofakeit.IntRange(0, 20))
+	}
+
+	stoppage := WorkStoppage{
+		Reason:          reason,
+		LastDayWorked:   lastDayWorked,
+		ExpectedEndDate: expectedEndDate,
+		Subrogation:     drawCode("S21.G00.60.004"),
+	}
+
+	if stoppage.Subrogation == Yes {
+		subrogationStart := lastDayWorked.AddDate(0, 0, 1)
+		subrogationEnd := expectedEndDate
+		stoppage.SubrogationStartDate = &subrogationStart
+		stoppage.SubrogationEndDate = &subrogationEnd
+		stoppage.IBAN = generateIBAN()
+		stoppage.BIC = generateBIC()
+	}
+
+	// The employee came back before the end of the declared month
+	if expectedEndDate.Before(monthEnd) {
+		resumptionDate := expectedEndDate.AddDate(0, 0, 1)
+		stoppage.ResumptionDate = &resumptionDate
+		stoppage.ResumptionReason = drawCode("S21.G00.60.011")
+	}
+
+	switch reason {
+	case StoppageWorkAccident, StoppageCommutingAccident, StoppageOccupationalIll:
+		accidentDate := gofakeit.DateRange(lastDayWorked.AddDate(0, 0, -30), lastDayWorked)
+		stoppage.AccidentDate = &accidentDate
+	}
+
+	return stoppage
+}
+
+// workStoppagePeriod returns the first and last days of absence of a WorkStoppage
+func workStoppagePeriod(stoppage WorkStoppage) (time.Time, time.Time) {
+	end := stoppage.ExpectedEndDate
+	if stoppage.ResumptionDate != nil {
+		end = stoppage.ResumptionDate.AddDate(0, 0, -1)
+	}
+	return stoppage.LastDayWorked.AddDate(0, 0, 1), end
+}
+
+func generateIBAN() string {
+	// French IBAN: FR + 2 check digits + 23 characters of BBAN
+	return "FR" + gofakeit.DigitN(25)
+}
+
+func generateBIC() string {
+	// BIC format: 4 letters (bank) + FR + 2 characters (location)
+	return strings.ToUpper(gofakeit.LetterN(4)) + "FR" + strings.ToUpper(gofakeit.LetterN(2))
+}
+
+// ContractEnd represents the termination of a contract
+// French: Fin de contrat
+type ContractEnd struct {
+	EndDate                   time.Time  `dsn:"S21.G00.62.001"`           // Date de fin du contrat
+	Reason                    string     `dsn:"S21.G00.62.002"`           // Motif de la rupture du contrat
+	NotificationDate          *time.Time `dsn:"S21.G00.62.003,omitempty"` // Date de notification de la rupture de contrat
+	ConventionSignatureDate   *time.Time `dsn:"S21.G00.62.004,omitempty"` // Date de signature de la convention de rupture
+	DismissalProcedureDate    *time.Time `dsn:"S21.G00.62.005,omitempty"` // Date d'engagement de la procédure de licenciement
+	LastDayPaid               time.Time  `dsn:"S21.G00.62.006"`           // Dernier jour travaillé et payé au salaire habituel
+	OngoingSettlement         string     `dsn:"S21.G00.62.008"`           // Transaction en cours
+	SpecialStatus             string     `dsn:"S21.G00.62.014"`           // Statut particulier du salarié
+	CollectiveAffiliationKept string     `dsn:"S21.G00.62.016"`           // Maintien de l'affiliation du salarié au contrat collectif
+	Notices                   []Notice   `dsn:"S21.G00.63"`               // Préavis de fin de contrat
+}
+
+const (
+	EndDismissalEconomic  = "014" // Licenciement pour motif économique
+	EndDismissalOther     = "020" // Licenciement pour autre motif
+	EndFixedTerm          = "031" // Fin de contrat à durée déterminée
+	EndTrialByEmployer    = "034" // Fin de période d'essai à l'initiative de l'employeur
+	EndTrialByEmployee    = "035" // Fin de période d'essai à l'initiative du salarié
+	EndRetirement         = "039" // Départ à la retraite à l'initiative du salarié
+	EndConventionalBreach = "043" // Rupture conventionnelle
+	EndResignation        = "059" // Démission
+)
+
+// Notice represents the notice period preceding the end of a contract
+// French: Préavis de fin de contrat
+type Notice struct {
+	Type      string    `dsn:"S21.G00.63.001"` // Type réalisation et paiement du préavis
+	StartDate time.Time `dsn:"S21.G00.63.002"` // Date de début de préavis
+	EndDate   time.Time `dsn:"S21.G00.63.003"` // Date de fin de préavis
+}
+
+const (
+	NoticeWorkedPaid    = "01" // Préavis effectué et payé
+	NoticeNotWorkedPaid = "02" // Préavis non effectué et payé
+)
+
+// contractEndReasons lists the reasons which can end each type of contract.
+// Other types of contracts are not ended by the generator.
+var contractEndReasons = map[string][]string{
+	ContractPermanent:             {EndDismissalEconomic, EndDismissalOther, EndTrialByEmployer, EndTrialByEmployee, EndRetirement, EndConventionalBreach, EndResignation},
+	ContractPermanentIntermittent: {EndDismissalEconomic, EndDismissalOther, EndTrialByEmployer, EndTrialByEmployee, EndRetirement},
+	ContractPublicPermanent:       {EndDismissalOther, EndTrialByEmployer, EndTrialByEmployee, EndRetirement},
+	ContractFixedTerm:             {EndFixedTerm, EndTrialByEmployer, EndTrialByEmployee},
+	ContractTemporaryWork:         {EndFixedTerm, EndTrialByEmployer, EndTrialByEmployee},
+	ContractPublicFixedTerm:       {EndFixedTerm, EndTrialByEmployer, EndTrialByEmployee},
+	ContractInternship:            {EndFixedTerm},
+}
+
+// GenerateContractEnd creates a new ContractEnd within the declared month,
+// with its Notice when the termination reason requires one. ok is false when
+// no reason can end the contract during the month.
+func GenerateContractEnd(contract Contrat, month time.Time) (ContractEnd, *Notice, bool) {
+	reasons := GetNomenclature("S21.G00.62.002").Only(contractEndReasons[contract.ContractType]...)
+	// A fixed-term contract reaches its term only when it falls within the
+	// declared month
+	if contract.ExpectedEndDate.Before(firstDayOfMonth(month)) || contract.ExpectedEndDate.After(lastDayOfMonth(month)) {
+		reasons = reasons.Except(EndFixedTerm)
+	}
+	// The trial period only ends in the first months of the contract
+	if contract.ContractStartDate.Before(firstDayOfMonth(month).AddDate(0, -maxTrialPeriodMonths, 0)) {
+		reasons = reasons.Except(EndTrialByEmployer, EndTrialByEmployee)
+	}
+	if len(reasons) == 0 {
+		return ContractEnd{}, nil, false
+	}
+	reason := reasons.Draw()
+
+	endDate := randomDay(maxDate(contract.ContractStartDate.AddDate(0, 0, 1), firstDayOfMonth(month)), lastDayOfMonth(month))
+	if reason == EndFixedTerm {
+		endDate = contract.ExpectedEndDate
+	}
+	end := ContractEnd{
+		EndDate:                   endDate,
+		Reason:                    reason,
+		LastDayPaid:               endDate,
+		OngoingSettlement:         No,
+		SpecialStatus:             drawCode("S21.G00.62.014"),
+		CollectiveAffiliationKept: drawCode("S21.G00.62.016"),
+	}
+
+	var notice *Notice
+	switch end.Reason {
+	case EndDismissalEconomic, EndDismissalOther, EndRetirement, EndResignation:
+		noticeMonths := gofakeit.IntRange(1, 3)
+		notice = &Notice{
+			Type:      drawCode("S21.G00.63.001"),
+			StartDate: endDate.AddDate(0, -noticeMonths, 1),
+			EndDate:   endDate,
+		}
+		// The notice period can not start before the contract
+		notice.StartDate = maxDate(notice.StartDate, contract.ContractStartDate.AddDate(0, 0, 1))
+		// The employee is exempted from the end of the notice during the
+		// declared month, and is paid an indemnity instead
+		if notice.Type == NoticeNotWorkedPaid {
+			end.LastDayPaid = randomDay(maxDate(notice.StartDate, firstDayOfMonth(month)), endDate)
+		}
+
+		notificationDate := notice.StartDate
+		end.NotificationDate = &notificationDate
+		if end.Reason == EndDismissalEconomic || end.Reason == EndDismissalOther {
+			procedureDate := maxDate(notificationDate.AddDate(0, 0, -gofakeit.IntRange(7, 30)), contract.ContractStartDate)
+			end.DismissalProcedureDate = &procedureDate
+		}
+	case EndConventionalBreach:
+		signatureDate := maxDate(endDate.AddDate(0, 0, -gofakeit.IntRange(30, 90)), contract.ContractStartDate)
+		end.ConventionSignatureDate = &signatureDate
+	case EndTrialByEmployer, EndTrialByEmployee:
+		notificationDate := maxDate(endDate.AddDate(0, 0, -gofakeit.IntRange(1, 14)), contract.ContractStartDate)
+		end.NotificationDate = &notificationDate
+	}
+
+	return end, notice, true
+}
+
+// Suspension represents a suspension of the contract other than a work stoppage
+// French: Autre suspension de l'exécution du contrat
+type Suspension struct {
+	Reason               string     `dsn:"S21.G00.65.001"`           // Motif de suspension
+	StartDate            time.Time  `dsn:"S21.G00.65.002"`           // Date de début de la suspension
+	EndDate              *time.Time `dsn:"S21.G00.65.003,omitempty"` // Date de fin de la suspension
+	SecondmentPosition   string     `dsn:"S21.G00.65.004,omitempty"` // [FP] Position de détachement
+	SuspendedWorkingDays int        `dsn:"S21.G00.65.005"`           // Nombre de jours ouvrés de suspension
+}
+
+const (
+	SuspensionUnpaidLeave      = "501" // Congé divers non rémunéré
+	SuspensionParentalLeave    = "502" // Congé parental d'éducation
+	SuspensionBusinessCreation = "503" // Congé pour la création d'entreprise
+	SuspensionSabbatical       = "504" // Congé sabbatique
+	SuspensionLeaveWithoutPay  = "505" // Congé sans solde
+)
+
+// GenerateSuspension creates a new Suspension of the contract overlapping the declared month
+func GenerateSuspension(contract Contrat, month time.Time) Suspension {
+	reason := drawCode("S21.G00.65.001")
+
+	monthStart := firstDayOfMonth(month)
+	monthEnd := lastDayOfMonth(month)
+
+	// Typical durations of each kind of leave, in days
+	var minDays, maxDays int
+	switch reason {
+	case SuspensionParentalLeave:
+		minDays, maxDays = 60, 365
+	case SuspensionBusinessCreation, SuspensionSabbatical:
+		minDays, maxDays = 180, 330
+	default:
+		minDays, maxDays = 1, 30
+	}
+
+	startDate := randomDay(maxDate(monthStart.AddDate(0, 0, -maxDays), contract.ContractStartDate.AddDate(0, 0, 1)), monthEnd)
+	endDate := startDate.AddDate(0, 0, gofakeit.IntRange(minDays, maxDays)-1)
+	// The suspension must still be running at the beginning of the month
+	endDate = maxDate(endDate, monthStart)
+
+	suspension := Suspension{
+		Reason:               reason,
+		StartDate:            startDate,
+		SuspendedWorkingDays: workingDaysBetween(maxDate(startDate, monthStart), minDate(endDate, monthEnd)),
+	}
+
+	// The end date is only declared once known, i.e. when the employee came
+	// back during the declared month
+	if !endDate.After(monthEnd) {
+		suspension.EndDate = &endDate
+	}
+
+	return suspension
+}
+
+// suspensionPeriod returns the first and last days of a Suspension within the declared month
+func suspensionPeriod(suspension Suspension, month time.Time) (time.Time, time.Time) {
+	if suspension.EndDate == nil {
+		return suspension.StartDate, lastDayOfMonth(month)
+	}
+	return suspension.StartDate, *suspension.EndDate
+}
+
+// TherapeuticPartTime represents a part-time resumption of work prescribed
+// after a sick leave
+// French: Temps partiel Thérapeutique
+type TherapeuticPartTime struct {
+	StartDate time.Time  `dsn:"S21.G00.66.001"`           // Date de début
+	EndDate   *time.Time `dsn:"S21.G00.66.002,omitempty"` // Date de fin
+	Amount    float64    `dsn:"S21.G00.66.003,amount"`    // Montant
+}
+
+// GenerateTherapeuticPartTime creates a new TherapeuticPartTime overlapping
+// the declared month. The amount is the share of the monthly salary paid for
+// the hours worked during the month.
+func GenerateTherapeuticPartTime(contract Contrat, month time.Time, monthlySalary float64) TherapeuticPartTime {
+	monthStart := firstDayOfMonth(month)
+	monthEnd := lastDayOfMonth(month)
+
+	startDate := randomDay(maxDate(monthStart.AddDate(0, -3, 0), contract.ContractStartDate.AddDate(0, 0, 1)), monthEnd)
+	endDate := maxDate(startDate.AddDate(0, gofakeit.IntRange(1, 6), 0), monthStart)
+
+	// Share of the usual working time during the therapeutic part-time
+	shares := []float64{0.5, 0.6, 0.8}
+	share := shares[gofakeit.IntRange(0, len(shares)-1)]
+	days := workingDaysBetween(maxDate(startDate, monthStart), minDate(endDate, monthEnd))
+	monthDays := workingDaysBetween(monthStart, monthEnd)
+
+	therapeutic := TherapeuticPartTime{
+		StartDate: startDate,
+		Amount:    roundAmount(monthlySalary * share * float64(days) / float64(monthDays)),
+	}
+	if !endDate.After(monthEnd) {
+		therapeutic.EndDate = &endDate
+	}
+
+	return therapeutic
+}
+
+// WrongfulPensionAffiliation represents the correction of previous months
+// declared to the wrong complementary pension scheme
+// French: Affiliation à tort à un régime de retraite complémentaire
+type WrongfulPensionAffiliation struct {
+	SchemeCode           string                  `dsn:"S21.G00.72.001"` // Code régime Retraite Complémentaire déclaré à tort
+	EmployerMembershipID string                  `dsn:"S21.G00.72.002"` // Référence adhésion employeur déclarée à tort
+	Periods              []WrongfulPensionPeriod `dsn:"S21.G00.83"`     // Période déclarée à tort
+}
+
+// WrongfulPensionPeriod represents a period declared to the wrong complementary pension scheme
+// French: Période d'affiliation à tort à un régime de retraite complémentaire
+type WrongfulPensionPeriod struct {
+	StartDate time.Time             `dsn:"S21.G00.83.001"` // Date de début de période déclarée à tort
+	EndDate   time.Time             `dsn:"S21.G00.83.002"` // Date de fin de période déclarée à tort
+	Bases     []WrongfulPensionBase `dsn:"S21.G00.84"`     // Base assujettie déclarée à tort
+}
+
+// WrongfulPensionBase represents a SubjectBase previously declared to the
+// wrong complementary pension scheme
+// French: Base assujettie déclarée à tort pour un régime de retraite complémentaire
+type WrongfulPensionBase struct {
+	Code            string    `dsn:"S21.G00.84.001"`        // Code de base assujettie déclarée à tort
+	PeriodStartDate time.Time `dsn:"S21.G00.84.002"`        // Date de début de période de rattachement de la base déclarée à tort
+	PeriodEndDate   time.Time `dsn:"S21.G00.84.003"`        // Date de fin de période de rattachement de la base déclarée à tort
+	Amount          float64   `dsn:"S21.G00.84.004,amount"` // Montant déclaré à tort
+	ContractNumber  string    `dsn:"S21.G00.84.005"`        // Numéro du contrat rattaché à la base assujettie déclarée à tort
+}
+
+// GenerateWrongfulPensionAffiliation creates the correction of the months
+// preceding the declared month, during which the contract was declared to
+// another scheme than the one of pension. The contract must have started
+// before the declared month. The bases declared by mistake are the ones of a
+// full month paid monthlySalary.
+func GenerateWrongfulPensionAffiliation(contract Contrat, pension SupplementaryPension, month time.Time, monthlySalary float64) WrongfulPensionAffiliation {
+	schemes := GetNomenclature("S21.G00.72.001").Except(pension.SchemeCode)
+
+	// The mistake lasted from one to three months, and at most since the
+	// beginning of the contract
+	monthStart := firstDayOfMonth(month)
+	startDate := maxDate(monthStart.AddDate(0, -gofakeit.IntRange(1, 3), 0), truncateToDay(contract.ContractStartDate))
+	endDate := monthStart.AddDate(0, 0, -1)
+
+	period := WrongfulPensionPeriod{
+		StartDate: startDate,
+		EndDate:   endDate,
+	}
+	for m := firstDayOfMonth(startDate); m.Before(monthStart); m = m.AddDate(0, 1, 0) {
+		for _, code := range []string{BaseCapped, BaseUncapped} {
+			amount := monthlySalary
+			if code == BaseCapped {
+				amount = math.Min(monthlySalary, socialSecurityCeiling(m))
+			}
+			period.Bases = append(period.Bases, WrongfulPensionBase{
+				Code:            code,
+				PeriodStartDate: maxDate(m, startDate),
+				PeriodEndDate:   lastDayOfMonth(m),
+				Amount:          roundAmount(amount),
+				ContractNumber:  contract.ContractNumber,
+			})
+		}
+	}
+
+	return WrongfulPensionAffiliation{
+		SchemeCode:           schemes.Draw(),
+		EmployerMembershipID: gofakeit.DigitN(8),
+		Periods:              []WrongfulPensionPeriod{period},
+	}
+}
+
+// ProvidentAffiliation represents the affiliation of an employee to the
+// provident (health, death, disability) plan of the company
+// French: Affiliation Prévoyance
+type ProvidentAffiliation struct {
+	OptionCode        string     `dsn:"S21.G00.70.004"`           // Code option retenue par le salarié
+	PopulationCode    string     `dsn:"S21.G00.70.005"`           // Code population de rattachement
+	DependentChildren int        `dsn:"S21.G00.70.007"`           // Nombre d'enfants à charge
+	AdultDependants   int        `dsn:"S21.G00.70.008"`           // Nombre d'adultes ayants-droit (conjoint, concubin, ...)
+	Dependants        int        `dsn:"S21.G00.70.009"`           // Nombre d'ayants-droit
+	OtherDependants   int        `dsn:"S21.G00.70.010"`           // Nombre d'ayants-droit autres (ascendants, collatéraux...)
+	ChildDependants   int        `dsn:"S21.G00.70.011"`           // Nombre d'enfants ayants-droit
+	AffiliationID     string     `dsn:"S21.G00.70.012"`           // Identifiant technique Affiliation
+	MembershipID      string     `dsn:"S21.G00.70.013"`           // Identifiant technique Adhésion
+	StartDate         time.Time  `dsn:"S21.G00.70.014"`           // Date de début de l'affiliation
+	EndDate           *time.Time `dsn:"S21.G00.70.015,omitempty"` // Date de fin de l'affiliation
+}
+
+// GenerateProvidentAffiliation creates the ProvidentAffiliation of a contract
+// to the provident plan membershipID, covering the given dependants
+func GenerateProvidentAffiliation(contract Contrat, contractEnd *ContractEnd, membershipID string, optionCode string, dependants []Dependant) ProvidentAffiliation {
+	affiliation := ProvidentAffiliation{
+		OptionCode:     optionCode,
+		PopulationCode: contract.MandatorySupplementaryPensionCode,
+		Dependants:     len(dependants),
+		AffiliationID:  gofakeit.DigitN(5),
+		MembershipID:   membershipID,
+		StartDate:      contract.ContractStartDate,
+	}
+
+	for _, dependant := range dependants {
+		switch dependant.Type {
+		case DependantSpouse, DependantPartner:
+			affiliation.AdultDependants++
+		case DependantChild:
+			affiliation.ChildDependants++
+			affiliation.DependentChildren++
+		default:
+			affiliation.OtherDependants++
+		}
+	}
+
+	if contractEnd != nil {
+		endDate := contractEnd.EndDate
+		affiliation.EndDate = &endDate
+	}
+
+	return affiliation
+}
+
+// Dependant represents a family member covered by the provident plan of an employee
+// French: Ayant-droit
+type Dependant struct {
+	AlsaceMoselleScheme     string     `dsn:"S21.G00.73.001"`           // Régime local Alsace-Moselle
+	OptionCode              string     `dsn:"S21.G00.73.002"`           // Code option
+	Type                    string     `dsn:"S21.G00.73.003"`           // Type
+	AttachmentStartDate     time.Time  `dsn:"S21.G00.73.004"`           // Date de début de rattachement à l'ouvrant-droit
+	BirthDate               time.Time  `dsn:"S21.G00.73.005"`           // Date de naissance
+	LastName                string     `dsn:"S21.G00.73.006,upper"`     // Nom de famille
+	NIR                     string     `dsn:"S21.G00.73.007,omitempty"` // Numéro d'inscription au répertoire
+	HolderNIR               string     `dsn:"S21.G00.73.008,omitempty"` // NIR ouvrant-droit régime de base maladie
+	FirstNames              string     `dsn:"S21.G00.73.009,upper"`     // Prénoms
+	HealthInsuranceOrganism string     `dsn:"S21.G00.73.010,omitempty"` // Code organisme d'affiliation à l'assurance maladie
+	AttachmentEndDate       *time.Time `dsn:"S21.G00.73.011,omitempty"` // Date de fin de rattachement à l'ouvrant-droit
+}
+
+const (
+	DependantSpouse  = "01" // Conjoint
+	DependantChild   = "02" // Enfant
+	DependantOther   = "03" // Autre (ascendant, collatéral...)
+	DependantPartner = "04" // Concubin ou partenaire de PACS
+)
+
+// GenerateDependants creates up to n dependants of an individual, attached to
+// the provident plan from the affiliation date. Children under 16 have no NIR
+// of their own and are covered under the NIR of the individual.
+func GenerateDependants(individual Individual, affiliationDate time.Time, month time.Time, optionCode string, n int) []Dependant {
+	var dependants []Dependant
+	hasAdult := false
+
+	for range n {
+		var dependant Dependant
+		if !hasAdult && chance(0.5) {
+			hasAdult = true
+			gender := Female
+			if individual.Gender == Female {
+				gender = Male
+			}
+			lastName := individual.LastName
+			if chance(0.3) {
+				lastName = gofakeit.LastName()
+			}
+			dependant = Dependant{
+				Type:                    GetNomenclature("S21.G00.73.003").Only(DependantSpouse, DependantPartner).Draw(),
+				BirthDate:               randomDay(individual.BirthDate.AddDate(-5, 0, 0), individual.BirthDate.AddDate(5, 0, 0)),
+				LastName:                lastName,
+				NIR:                     generateNIR(gender),
+				FirstNames:              gofakeit.FirstName(),
+				HealthInsuranceOrganism: gofakeit.DigitN(9),
+			}
+		} else {
+			// Children are born while the individual is between 20 and 45,
+			// and are covered until 25
+			from := maxDate(individual.BirthDate.AddDate(20, 0, 0), month.AddDate(-25, 0, 0))
+			to := minDate(individual.BirthDate.AddDate(45, 0, 0), month)
+			if from.After(to) {
+				continue
+			}
+			dependant = Dependant{
+				Type:       DependantChild,
+				BirthDate:  randomDay(from, to),
+				LastName:   individual.LastName,
+				FirstNames: gofakeit.FirstName(),
+			}
+			if !dependant.BirthDate.Before(month.AddDate(-16, 0, 0)) {
+				dependant.HolderNIR = individual.NIR
+			} else {
+				dependant.NIR = generateNIR(generateGender())
+				dependant.HealthInsuranceOrganism = gofakeit.DigitN(9)
+			}
+		}
+
+		dependant.AlsaceMoselleScheme = No
+		dependant.OptionCode = optionCode
+		dependant.AttachmentStartDate = maxDate(affiliationDate, dependant.BirthDate)
+		dependants = append(dependants, dependant)
+	}
+
+	return dependants
+}
+
+const (
+	PensionCategoryExecutive          = "01" // Cadre (articles 4 et 4bis de la convention AGIRC de 1947)
+	PensionCategoryExecutiveExtension = "02" // Extension cadre pour retraite complémentaire (article 36)
+	PensionCategoryNonExecutive       = "04" // Non cadre
+	PensionCategoryOther              = "98" // Retraite complémentaire ne relevant pas de l'Agirc-Arrco
+	PensionCategoryNone               = "99" // Pas de retraite complémentaire
+)
+
+// SupplementaryPension represents the complementary pension scheme of a contract
+// French: Retraite complémentaire
+type SupplementaryPension struct {
+	SchemeCode           string `dsn:"S21.G00.71.002"` // Code régime Retraite Complémentaire
+	EmployerMembershipID string `dsn:"S21.G00.71.003"` // Référence adhésion employeur
+}
+
+const (
+	PensionSchemeAgircArrco = "RUAA"     // Agirc-Arrco
+	PensionSchemeIRCANTEC   = "IRCANTEC" // Ircantec
+	PensionSchemeCRPNPAC    = "CRPNPAC"  // Personnel navigant de l'aéronautique civile
+)
+
+// GenerateSupplementaryPension creates the SupplementaryPension of a contract,
+// according to its Contrat.MandatorySupplementaryPensionCode. ok is false when
+// the contract has no complementary pension.
+func GenerateSupplementaryPension(contract Contrat, employerMembershipID string) (pension SupplementaryPension, ok bool) {
+	switch contract.MandatorySupplementaryPensionCode {
+	case PensionCategoryExecutive, PensionCategoryExecutiveExtension, PensionCategoryNonExecutive:
+		return SupplementaryPension{
+			SchemeCode:           PensionSchemeAgircArrco,
+			EmployerMembershipID: employerMembershipID,
+		}, true
+	case PensionCategoryOther:
+		return SupplementaryPension{
+			SchemeCode:           GetNomenclature("S21.G00.71.002").Only(PensionSchemeIRCANTEC, PensionSchemeCRPNPAC).Draw(),
+			EmployerMembershipID: employerMembershipID,
+		}, true
+	default:
+		return SupplementaryPension{}, false
+	}
+}
+
+// SubjectBase represents an amount on which contributions are computed
+// French: Base assujettie
+type SubjectBase struct {
+	Code            string          `dsn:"S21.G00.78.001"`           // Code de base assujettie
+	PeriodStartDate time.Time       `dsn:"S21.G00.78.002"`           // Date de début de période de rattachement
+	PeriodEndDate   time.Time       `dsn:"S21.G00.78.003"`           // Date de fin de période de rattachement
+	Amount          float64         `dsn:"S21.G00.78.004,amount"`    // Montant
+	AffiliationID   string          `dsn:"S21.G00.78.005,omitempty"` // Identifiant technique Affiliation
+	ContractNumber  string          `dsn:"S21.G00.78.006"`           // Numéro du contrat
+	Components      []BaseComponent `dsn:"S21.G00.79"`               // Composant de base assujettie
+}
+
+const (
+	BaseCapped       = "02" // Assiette brute plafonnée
+	BaseUncapped     = "03" // Assiette brute déplafonnée
+	BaseCSG          = "04" // Assiette de la contribution sociale généralisée
+	BaseUnemployment = "07" // Assiette des contributions d'Assurance chômage
+	BaseProvident    = "31" // Eléments de cotisation de prévoyance, santé, retraite supplémentaire
+)
+
+// BaseComponent represents an amount included in a SubjectBase that has to be
+// declared on its own
+// French: Composant de base assujettie
+type BaseComponent struct {
+	Type   string  `dsn:"S21.G00.79.001"`        // Type de composant de base assujettie
+	Amount float64 `dsn:"S21.G00.79.004,amount"` // Montant de composant de base assujettie
+}
+
+const (
+	ComponentEmployerProvident = "03" // Montant des contributions patronales de prévoyance complémentaire
+)
+
+// providentEmployerRate is the share of the capped base paid by the employer
+// to the provident plan
+const providentEmployerRate = 0.015
+
+// csgAllowanceRate is the flat-rate deduction for professional expenses
+// applied to the CSG base, up to 4 times the social security ceiling
+const csgAllowanceRate = 0.0175
+
+// socialSecurityCeilings holds the monthly social security ceiling (PMSS) of
+// each year
+var socialSecurityCeilings = map[int]float64{
+	2019: 3377,
+	2020: 3428,
+	2021: 3428,
+	2022: 3428,
+	2023: 3666,
+	2024: 3864,
+	2025: 3925,
+	2026: 4005,
+}
+
+// socialSecurityCeiling returns the monthly social security ceiling (PMSS)
+// applicable to the given month. Months before 2019 or after 2026 use the
+// ceiling of the closest known year.
+func socialSecurityCeiling(month time.Time) float64 {
+	year := min(max(month.Year(), 2019), 2026)
+	return socialSecurityCeilings[year]
+}
+
+// GenerateSubjectBases creates the SubjectBase of a contract for its
+// Remuneration and Bonus, applying the social security ceiling prorated to
+// the pay period
+func GenerateSubjectBases(contract Contrat, remuneration Remuneration, bonuses []Bonus, affiliation *ProvidentAffiliation) []SubjectBase {
+	gross := remuneration.Amount
+	for _, bonus := range bonuses {
+		// Dismissal indemnities are exempted within legal limits
+		if bonus.Type == IndemnityPaidLeave || bonus.Type == IndemnityNotice {
+			gross += bonus.Amount
+		}
+	}
+
+	monthStart := firstDayOfMonth(remuneration.PayPeriodStartDate)
+	periodDays := remuneration.PayPeriodEndDate.Sub(remuneration.PayPeriodStartDate).Hours()/24 + 1
+	monthDays := lastDayOfMonth(monthStart).Sub(monthStart).Hours()/24 + 1
+	ceiling := socialSecurityCeiling(monthStart) * math.Min(periodDays/monthDays, 1)
+	capped := math.Min(gross, ceiling)
+
+	newBase := func(code string, amount float64) SubjectBase {
+		return SubjectBase{
+			Code:            code,
+			PeriodStartDate: remuneration.PayPeriodStartDate,
+			PeriodEndDate:   remuneration.PayPeriodEndDate,
+			Amount:          roundAmount(amount),
+			ContractNumber:  contract.ContractNumber,
+		}
+	}
+
+	bases := []SubjectBase{
+		newBase(BaseCapped, capped),
+		newBase(BaseUncapped, gross),
+	}
+
+	csg := gross - math.Min(gross, 4*ceiling)*csgAllowanceRate
+	var components []BaseComponent
+	if affiliation != nil {
+		employerProvident := roundAmount(capped * providentEmployerRate)
+		csg += employerProvident
+		components = append(components, BaseComponent{
+			Type:   ComponentEmployerProvident,
+			Amount: employerProvident,
+		})
+	}
+	csgBase := newBase(BaseCSG, csg)
+	csgBase.Components = components
+	bases = append(bases, csgBase)
+
+	bases = append(bases, newBase(BaseUnemployment, math.Min(gross, 4*ceiling)))
+
+	// The provident plan covers the capped part of the salary
+	if affiliation != nil {
+		providentBase := newBase(BaseProvident, capped)
+		providentBase.AffiliationID = affiliation.AffiliationID
+		bases = append(bases, providentBase)
+	}
+
+	return bases
+}
+
+// IndividualContribution represents a contribution computed on a SubjectBase
+// French: Cotisation individuelle
+type IndividualContribution struct {
+	Code       string  `dsn:"S21.G00.81.001"`        // Code de cotisation
+	OrganismID string  `dsn:"S21.G00.81.002"`        // Identifiant Organisme de Protection Sociale
+	BaseAmount float64 `dsn:"S21.G00.81.003,amount"` // Montant d'assiette
+	Amount     float64 `dsn:"S21.G00.81.004,amount"` // Montant de cotisation
+	Rate       float64 `dsn:"S21.G00.81.007,rate"`   // Taux de cotisation
+}
+
+// contributionRate is the rate of a contribution, in percent of its base
+type contributionRate struct {
+	Code string
+	Rate float64
+}
+
+// contributionRates lists the contributions computed on each kind of SubjectBase
+var contributionRates = map[string][]contributionRate{
+	BaseCapped: {
+		{"076", 15.45}, // Assurance vieillesse plafonnée (6,90 % salarié + 8,55 % employeur)
+	},
+	BaseUncapped: {
+		{"075", 2.42}, // Assurance vieillesse déplafonnée (0,40 % salarié + 2,02 % employeur)
+		{"077", 7.00}, // Assurance maladie
+		{"074", 3.45}, // Allocations familiales
+	},
+	BaseCSG: {
+		{"072", 6.80}, // CSG déductible
+		{"073", 2.90}, // CSG non déductible et CRDS
+	},
+	BaseUnemployment: {
+		{"040", 4.05}, // Contribution d'Assurance chômage
+		{"048", 0.25}, // Cotisation AGS
+	},
+	BaseProvident: {
+		{"059", providentEmployerRate * 100}, // Prévoyance, part employeur
+	},
+}
+
+// GenerateIndividualContributions creates the IndividualContribution computed
+// on a SubjectBase, paid to the organism organismID
+func GenerateIndividualContributions(base SubjectBase, organismID string) []IndividualContribution {
+	var contributions []IndividualContribution
+	for _, rate := range contributionRates[base.Code] {
+		contributions = append(contributions, IndividualContribution{
+			Code:       rate.Code,
+			OrganismID: organismID,
+			BaseAmount: base.Amount,
+			Amount:     roundAmount(base.Amount * rate.Rate / 100),
+			Rate:       rate.Rate,
+		})
+	}
+	return contributions
+}
+
+// EstablishmentContribution represents a contribution due by the
+// establishment as a whole rather than for each individual
+// French: Cotisation établissement
+type EstablishmentContribution struct {
+	Value           float64   `dsn:"S21.G00.82.001,amount"`    // Valeur
+	Code            string    `dsn:"S21.G00.82.002"`           // Code de cotisation
+	PeriodStartDate time.Time `dsn:"S21.G00.82.003"`           // Date de début de période de rattachement
+	PeriodEndDate   time.Time `dsn:"S21.G00.82.004"`           // Date de fin de période de rattachement
+	Reference       string    `dsn:"S21.G00.82.005,omitempty"` // Référence réglementaire ou contractuelle
+}
+
+// GenerateEstablishmentContributions creates the EstablishmentContribution due
+// for the declared month, computed on the total gross salary of the establishment
+func GenerateEstablishmentContributions(establishment Establishment, totalGross float64, month time.Time) []EstablishmentContribution {
+	rates := []contributionRate{
+		{"001", 0.016}, // Contribution au dialogue social
+		{"002", 0.59},  // Taxe d'apprentissage
+	}
+	// Contribution à la formation professionnelle
+	if establishment.WorkforceAtEndOfPeriod < 11 {
+		rates = append(rates, contributionRate{"003", 0.55})
+	} else {
+		rates = append(rates, contributionRate{"003", 1.00})
+	}
+
+	var contributions []EstablishmentContribution
+	for _, rate := range rates {
+		contributions = append(contributions, EstablishmentContribution{
+			Value:           roundAmount(totalGross * rate.Rate / 100),
+			Code:            rate.Code,
+			PeriodStartDate: firstDayOfMonth(month),
+			PeriodEndDate:   lastDayOfMonth(month),
+		})
+	}
+	return contributions
+}
+
+// Seniority represents the seniority of an individual under a contract
+// French: Ancienneté
+type Seniority struct {
+	Type           string `dsn:"S21.G00.86.001"` // Type
+	Unit           string `dsn:"S21.G00.86.002"` // Unité de mesure
+	Value          int    `dsn:"S21.G00.86.003"` // Valeur
+	ContractNumber string `dsn:"S21.G00.86.005"` // Numéro du contrat
+}
+
+const (
+	SeniorityInCompany = "01" // Ancienneté dans l'entreprise
+
+	SeniorityUnitMonth = "02" // Mois
+	SeniorityUnitYear  = "03" // Année
+)
+
+// GenerateSeniority creates the Seniority in the company of a contract at the
+// end of the declared month, in months during the first year and in years after
+func GenerateSeniority(contract Contrat, month time.Time) Seniority {
+	start := truncateToDay(contract.ContractStartDate)
+	end := lastDayOfMonth(month).AddDate(0, 0, 1)
+
+	months := (end.Year()-start.Year())*12 + int(end.Month()-start.Month())
+	if end.Day() < start.Day() {
+		months--
+	}
+
+	seniority := Seniority{
+		Type:           SeniorityInCompany,
+		Unit:           SeniorityUnitMonth,
+		Value:          months,
+		ContractNumber: contract.ContractNumber,
+	}
+	if months >= 12 {
+		seniority.Unit = SeniorityUnitYear
+		seniority.Value = months / 12
+	}
+
+	return seniority
+}
+
+// WrongfulBaseRegimeBase represents a SubjectBase previously declared to a
+// basic scheme (maladie, AT/MP or vieillesse) the contract is not subject to
+// French: Base assujettie déclarée à tort pour un régime de base risque maladie, AT/MP ou vieillesse
+type WrongfulBaseRegimeBase struct {
+	Code            string    `dsn:"S21.G00.95.001"`        // Code de base assujettie déclarée à tort
+	PeriodStartDate time.Time `dsn:"S21.G00.95.002"`        // Date de début de période de rattachement de la base déclarée à tort
+	PeriodEndDate   time.Time `dsn:"S21.G00.95.003"`        // Date de fin de période de rattachement de la base déclarée à tort
+	Amount          float64   `dsn:"S21.G00.95.004,amount"` // Montant déclaré à tort
+	ContractNumber  string    `dsn:"S21.G00.95.005"`        // Numéro du contrat rattaché à la base assujettie déclarée à tort
+}
+
+// GenerateWrongfulBaseRegimeBases creates the WrongfulBaseRegimeBase reversing
+// the gross bases among the previously declared bases, on which the basic
+// schemes contributions are computed
+func GenerateWrongfulBaseRegimeBases(bases []SubjectBase) []WrongfulBaseRegimeBase {
+	var wrongful []WrongfulBaseRegimeBase
+	for _, base := range bases {
+		if base.Code != BaseCapped && base.Code != BaseUncapped {
+			continue
+		}
+		wrongful = append(wrongful, WrongfulBaseRegimeBase{
+			Code:            base.Code,
+			PeriodStartDate: base.PeriodStartDate,
+			PeriodEndDate:   base.PeriodEndDate,
+			Amount:          base.Amount,
+			ContractNumber:  base.ContractNumber,
+		})
+	}
+	return wrongful
+}
+
+// Total represents the totals of the envoi, closing the DSN file
+// French: Total de l'envoi
+type Total struct {
+	RubricCount      int `dsn:"S90.G00.90.001"` // Nombre total de rubriques
+	DeclarationCount int `dsn:"S90.G00.90.002"` // Nombre de DSN
+}
+
+// hoursPerWorkingDay is the legal daily working time for a full-time contract
+const hoursPerWorkingDay = 7.0
+
+func firstDayOfMonth(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+func lastDayOfMonth(t time.Time) time.Time {
+	return firstDayOfMonth(t).AddDate(0, 1, -1)
+}
+
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// randomDay returns a random day between start and end, both included
+func randomDay(start, end time.Time) time.Time {
+	return truncateToDay(gofakeit.DateRange(truncateToDay(start), truncateToDay(end)))
+}
+
+func minDate(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxDate(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// workingDaysBetween counts the days from Monday to Friday between start and
+// end, both included
+func workingDaysBetween(start, end time.Time) int {
+	start = truncateToDay(start)
+	end = truncateToDay(end)
+
+	days := 0
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if d.Weekday() != time.Saturday && d.Weekday() != time.Sunday {
+			days++
+		}
+	}
+	return days
 }
 
 func weirdDateFormat(t *time.Time) string {
 	return fmt.Sprintf("%d%02d%02d", t.Year(), t.Month(), t.Day())
 }
 
-// Serialize converts any struct with dsn tags to a slice of "code,'attribute'" format
+// Numeric formats of the rubrics, given as an option of the dsn tag, e.g. `dsn:"S21.G00.51.013,amount"`
+const (
+	FormatAmount  = "amount"  // Montant, two decimals
+	FormatRate    = "rate"    // Taux, three decimals
+	FormatQuotity = "quotity" // Quotité, two decimals
+	FormatInteger = "integer" // Entier, no decimals
+)
+
+// Options of the dsn tag besides the numeric format
+const (
+	// OptionOmitEmpty marks an optional rubric, which is not written when its
+	// value is empty. Rubrics without it are mandatory.
+	OptionOmitEmpty = "omitempty"
+	// OptionUpper marks a rubric whose value is written in uppercase
+	OptionUpper = "upper"
+)
+
+// tagOptions holds the options of a dsn tag
+type tagOptions struct {
+	Format    string
+	OmitEmpty bool
+	Upper     bool
+}
+
+// parseDSNTag splits a dsn tag into the rubric ID and its options,
+// e.g. `dsn:"S21.G00.52.002,amount,omitempty"`
+func parseDSNTag(tag string) (id string, options tagOptions) {
+	parts := strings.Split(tag, ",")
+	for _, option := range parts[1:] {
+		switch option {
+		case OptionOmitEmpty:
+			options.OmitEmpty = true
+		case OptionUpper:
+			options.Upper = true
+		default:
+			options.Format = option
+		}
+	}
+	return parts[0], options
+}
+
+// isEmpty reports whether the value of a rubric is absent: an empty string,
+// a nil pointer or a zero date. Numbers are never empty, as zero is a value.
+func isEmpty(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.String:
+		return value.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	}
+	if value.Type() == timeType {
+		return value.Interface().(time.Time).IsZero()
+	}
+	return false
+}
+
+// formatNumber formats a float rubric with the dot separator and the precision of its format
+func formatNumber(f float64, format string) (string, error) {
+	var decimals int
+	switch format {
+	case FormatAmount, FormatQuotity:
+		decimals = 2
+	case FormatRate:
+		decimals = 3
+	case FormatInteger:
+		decimals = 0
+	case "":
+		return "", fmt.Errorf("missing numeric format for %v", f)
+	default:
+		return "", fmt.Errorf("unknown numeric format %q", format)
+	}
+	s := strconv.FormatFloat(f, 'f', decimals, 64)
+	// Avoid writing negative zeros such as -0.00
+	if strings.Trim(s, "-0.") == "" {
+		s = strings.TrimPrefix(s, "-")
+	}
+	return s, nil
+}
+
+// DSNMarshaler is implemented by the types which encode their own rubric
+// value, e.g. a SIRET or an amount type
+type DSNMarshaler interface {
+	MarshalDSN() (string, error)
+}
+
+var (
+	dsnMarshalerType = reflect.TypeOf((*DSNMarshaler)(nil)).Elem()
+	timeType         = reflect.TypeOf(time.Time{})
+)
+
+// SIRET identifies an establishment by the SIREN of its company and its NIC
+type SIRET struct {
+	SIREN string
+	NIC   string
+}
+
+// MarshalDSN writes the SIRET as the 14 digits of the SIREN and the NIC
+func (s SIRET) MarshalDSN() (string, error) {
+	if s == (SIRET{}) {
+		return "", nil
+	}
+	if len(s.SIREN) != 9 || len(s.NIC) != 5 {
+		return "", fmt.Errorf("invalid SIRET: SIREN %q, NIC %q", s.SIREN, s.NIC)
+	}
+	return s.SIREN + s.NIC, nil
+}
+
+// Serialize converts any struct with dsn tags to a slice of "code,'attribute'" format.
+// Fields tagged with a bloc ID hold slices of structs serialized as nested blocs,
+// after the rubrics of v, each child starting with its own bloc header.
+// Values are sanitized to the DSN character set, then checked against the
+// Schema of their rubric. Empty optional rubrics are omitted, and empty
+// mandatory ones are an error.
 func Serialize(v interface{}) ([]string, error) {
 	var result []string
+	var children []string
 	rv := reflect.ValueOf(v)
 
 	if rv.Kind() != reflect.Struct {
@@ -605,44 +1911,96 @@ func Serialize(v interface{}) ([]string, error) {
 	for i := 0; i < rv.NumField(); i++ {
 		field := rv.Type().Field(i)
 		value := rv.Field(i)
-		dsnTag := field.Tag.Get("dsn")
+		dsnTag, options := parseDSNTag(field.Tag.Get("dsn"))
 		if dsnTag == "" {
 			continue
 		}
 
+		if _, ok := Blocs[BlocID(dsnTag)]; ok {
+			if value.Kind() != reflect.Slice || value.Type().Elem().Kind() != reflect.Struct {
+				return nil, fmt.Errorf("%s: nested bloc %s is not a slice of structs", dsnTag, field.Name)
+			}
+			for j := 0; j < value.Len(); j++ {
+				lines, err := Serialize(value.Index(j).Interface())
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v", dsnTag, err)
+				}
+				children = append(children, fmt.Sprintf("%s,''\n", dsnTag))
+				children = append(children, lines...)
+			}
+			continue
+		}
+
 		var strValue string
-		switch value.Kind() {
-		case reflect.String:
-			strValue = value.String()
-		case reflect.Float32, reflect.Float64:
-			strValue = fmt.Sprintf("%f", value.Float())
-		case reflect.Int32, reflect.Int64, reflect.Int, reflect.Int16, reflect.Int8, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-			strValue = fmt.Sprintf("%d", value.Int())
-		case reflect.Ptr:
-			// Handle pointer types
-			if value.IsNil() {
-				strValue = "NULL"
-			} else if value.Type() == reflect.TypeOf(&time.Time{}) {
-				// *time.Time
-				t := value.Interface().(*time.Time)
-				strValue = weirdDateFormat(t)
-			} else {
-				strValue = fmt.Sprintf("%v", value.Elem().Interface())
+		if !isEmpty(value) {
+			var err error
+			strValue, err = formatValue(value, options.Format)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", dsnTag, err)
 			}
-		default:
-			// Check if it's a time.Time
-			if value.Type() == reflect.TypeOf(time.Time{}) {
-				t := value.Interface().(time.Time)
-				strValue = weirdDateFormat(&t)
-			} else {
-				// For other types, use default string conversion
-				strValue = fmt.Sprintf("%v", value.Interface())
+			strValue = sanitize(strValue, options.Upper)
+		}
+		// The value may also be emptied by the sanitization
+		if strValue == "" {
+			if options.OmitEmpty {
+				continue
 			}
+			return nil, fmt.Errorf("%s: mandatory rubric %s is empty", dsnTag, field.Name)
+		}
+
+		attribute, ok := Attributes[AttributeID(dsnTag)]
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown rubric of field %s", dsnTag, field.Name)
 		}
-		result = append(result, fmt.Sprintf("%s,'%s'\n", dsnTag, strValue))
+		if err := attribute.Schema.Validate(strValue); err != nil {
+			return nil, fmt.Errorf("%s (%s): %v", dsnTag, field.Name, err)
+		}
+		result = append(result, fmt.Sprintf("%s,'%s'\n", dsnTag, escape(strValue)))
+	}
+
+	return append(result, children...), nil
+}
+
+// formatValue formats the value of a rubric, format being the numeric format
+// of its dsn tag
+func formatValue(value reflect.Value, format string) (string, error) {
+	if value.Type().Implements(dsnMarshalerType) {
+		return value.Interface().(DSNMarshaler).MarshalDSN()
+	}
+	// The struct fields are not addressable, so a copy is needed to call
+	// MarshalDSN when it has a pointer receiver
+	if reflect.PointerTo(value.Type()).Implements(dsnMarshalerType) {
+		ptr := reflect.New(value.Type())
+		ptr.Elem().Set(value)
+		return ptr.Interface().(DSNMarshaler).MarshalDSN()
+	}
+	if value.Type() == timeType {
+		t := value.Interface().(time.Time)
+		return weirdDateFormat(&t), nil
 	}
 
-	return result, nil
+	switch value.Kind() {
+	case reflect.String:
+		return value.String(), nil
+	case reflect.Bool:
+		if value.Bool() {
+			return Yes, nil
+		}
+		return No, nil
+	case reflect.Float32, reflect.Float64:
+		return formatNumber(value.Float(), format)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return "", fmt.Errorf("nil %v", value.Type())
+		}
+		return formatValue(value.Elem(), format)
+	default:
+		return "", fmt.Errorf("unsupported kind %v", value.Kind())
+	}
 }
 
 // SerializeToString converts any struct with dsn tags to a single string with "code,'attribute'" format
@@ -654,9 +2012,78 @@ func SerializeToString(v interface{}) (string, error) {
 	return strings.Join(serialized, "\n"), nil
 }
 
-const nIndividuals = 100
+const (
+	nIndividuals = 100
+	// Number of company officers who are not employees
+	nNonSalaried = 2
+
+	// Share of individuals with a work stoppage during the declared month
+	workStoppageShare = 0.1
+	// Share of contracts ending during the declared month
+	contractEndShare = 0.05
+	// Maximum length of a trial period, in months
+	maxTrialPeriodMonths = 4
+	// Share of contracts suspended for another reason than a work stoppage
+	suspensionShare = 0.05
+	// Share of contracts in therapeutic part-time
+	therapeuticPartTimeShare = 0.02
+	// Share of individuals affiliated to the provident plan of the company
+	providentAffiliationShare = 0.8
+	// Maximum number of dependants covered by the provident plan of an individual
+	maxDependants = 4
+	// Share of contracts correcting a wrongful complementary pension affiliation
+	wrongfulPensionAffiliationShare = 0.02
+	// Share of contracts whose previous month is declared wrongly subject to the basic schemes
+	wrongfulBaseRegimeShare = 0.02
+	// Share of contracts of disabled workers (BOETH)
+	disabledWorkerShare = 0.04
+	// Share of payments with an administrative garnishment
+	garnishmentShare = 0.03
+	// Number of people or companies paid fees during the year, in the year-end declaration
+	nFeeBeneficiaries = 5
+	// Share of executives acquiring defined-benefit pension rights, in the year-end declaration
+	definedBenefitPensionShare = 0.3
+	// Share of employees acquiring free shares, stock options or BSPCE, in the year-end declaration
+	equityCompensationShare = 0.1
+	// Number of workplaces of the company, including the establishment itself
+	nWorkplaces = 3
+	// Share of temporary work contracts, with a user establishment
+	temporaryWorkShare = 0.05
+)
 
-func main() {
+// dsnWriter writes the blocs of a DSN file, counting their rubrics for the
+// totals of the envoi. It stops at the first bloc which cannot be serialized,
+// and keeps its error.
+type dsnWriter struct {
+	*bufio.Writer
+	rubrics int
+	err     error
+}
+
+// writeBloc writes the header of the bloc followed by the rubrics of v
+func writeBloc(writer *dsnWriter, id BlocID, v interface{}) {
+	if writer.err != nil {
+		return
+	}
+	lines, err := Serialize(v)
+	if err != nil {
+		writer.err = fmt.Errorf("cannot serialize %s: %v", GetBloc(id).Label, err)
+		return
+	}
+	writer.WriteString(fmt.Sprintf("%s,''\n", id))
+	for _, line := range lines {
+		// Nested blocs start with their header, which is not a rubric
+		if code, _, _ := strings.Cut(line, ","); strings.Count(code, ".") == 3 {
+			writer.rubrics++
+		}
+		writer.WriteString(line)
+	}
+}
+
+// generateDSN writes a monthly DSN with random data to w, with the S89 blocs
+// of the year-end declaration if yearEnd is set. It returns the error of the
+// first bloc which cannot be serialized.
+func generateDSN(w io.Writer, yearEnd bool) error {
 	transmission := GenerateTransmission()
 	sender := GenerateSender()
 	senderContact := GenerateSenderContact()
@@ -664,122 +2091,319 @@ func main() {
 	company := GenerateCompany()
 	establishment := GenerateEstablishment()
 
-	_, err := os.OpenFile("dsn.txt", os.O_RDONLY, 0644)
-	if err == nil {
-		err = os.Remove("dsn.txt")
-		if err != nil {
-			log.Fatalf("could not delete DSN file: %v", err)
-		}
+	month := declaration.MainDeclarationMonth
+	// The employer joins a complementary pension institution once for all its employees
+	pensionMembershipID := gofakeit.DigitN(8)
+	providentMembershipID := gofakeit.DigitN(5)
+	providentOrganismID := "P" + gofakeit.DigitN(4)
+	urssafID := gofakeit.DigitN(14)
+	// Total gross salary of the establishment, on which its own contributions are computed
+	totalGross := 0.0
+	// Equity acquired by the employees, declared after the individuals in the year-end declaration
+	var pensionRights []DefinedBenefitPensionRight
+	var freeShares []FreeShareGrant
+	var stockOptions []StockOptionGrant
+	var warrants []WarrantGrant
+
+	// The establishment itself is the first workplace, the others are other
+	// sites of the company
+	workplaces := []Workplace{GenerateEstablishmentWorkplace(company, establishment)}
+	for range nWorkplaces - 1 {
+		workplaces = append(workplaces, GenerateWorkplace(company.SIREN+gofakeit.DigitN(5)))
 	}
 
-	file, err := os.OpenFile("dsn.txt", os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		log.Fatal(err)
-	}
-	defer file.Close()
+	writer := &dsnWriter{Writer: bufio.NewWriter(w)}
 
-	writer := bufio.NewWriter(file)
-	defer writer.Flush()
+	writeBloc(writer, "S10.G00.00", transmission)
+	writeBloc(writer, "S10.G00.01", sender)
+	writeBloc(writer, "S10.G00.02", senderContact)
+	writeBloc(writer, "S20.G00.05", declaration)
+	writeBloc(writer, "S21.G00.06", company)
+	writeBloc(writer, "S21.G00.11", establishment)
 
-	writer.WriteString("S10.G00.00,''\n")
-	lines, err := Serialize(transmission)
-	if err != nil {
-		log.Fatalf("cannot serialize transmission: %v", err)
-	}
-	for _, line := range lines {
-		writer.WriteString(line)
-	}
+	disabledWorkerStatuses := generateDisabledWorkerStatuses(nIndividuals)
+	writeBloc(writer, "S21.G00.13", GenerateDisabledWorkerComplement(disabledWorkerStatuses, month))
 
-	writer.WriteString("S10.G00.01,''\n")
-	lines, err = Serialize(sender)
-	if err != nil {
-		log.Fatalf("cannot serialize sender: %v", err)
-	}
-	for _, line := range lines {
-		writer.WriteString(line)
-	}
+	for i := range nIndividuals {
+		individual := GenerateIndividual()
+		writeBloc(writer, "S21.G00.30", individual)
+
+		workplace := workplaces[gofakeit.IntRange(0, len(workplaces)-1)]
+		contract := GenerateContract(month, workplace.ID)
+		contract.DisabledWorkerStatus = disabledWorkerStatuses[i]
+		var userEstablishment *Workplace
+		if contract.ContractType == ContractTemporaryWork {
+			u := GenerateWorkplace(gofakeit.DigitN(14))
+			userEstablishment = &u
+			contract.UserEstablishmentID = u.ID
+		}
 
-	writer.WriteString("S10.G00.02,''\n")
-	lines, err = Serialize(senderContact)
-	if err != nil {
-		log.Fatalf("cannot serialize senderContact: %v", err)
-	}
-	for _, line := range lines {
-		writer.WriteString(line)
-	}
+		var contractEnd *ContractEnd
+		var notice *Notice
+		if chance(contractEndShare) {
+			if e, n, ok := GenerateContractEnd(contract, month); ok {
+				contractEnd, notice = &e, n
+			}
+		}
 
-	writer.WriteString("S20.G00.05,''\n")
-	lines, err = Serialize(declaration)
-	if err != nil {
-		log.Fatalf("cannot serialize declaration: %v", err)
-	}
-	for _, line := range lines {
-		writer.WriteString(line)
-	}
+		payment := GeneratePayment()
+		var garnishment *Garnishment
+		if chance(garnishmentShare) {
+			g := GenerateGarnishment(payment, month)
+			garnishment = &g
+			payment = ApplyGarnishment(payment, g)
+		}
 
-	writer.WriteString("S21.G00.06,''\n")
-	lines, err = Serialize(company)
-	if err != nil {
-		log.Fatalf("cannot serialize company: %v", err)
-	}
-	for _, line := range lines {
-		writer.WriteString(line)
-	}
+		remuneration := GenerateRemuneration(contract.ContractNumber, month)
+		monthlySalary := remuneration.Amount
+		var bonuses []Bonus
+		lastDayPaid := remuneration.PayPeriodEndDate
+		if contractEnd != nil {
+			bonuses = GenerateTerminationIndemnities(contract, *contractEnd, notice, monthlySalary)
+			lastDayPaid = contractEnd.LastDayPaid
+		}
+		remuneration = ProrateRemuneration(remuneration, contract.ContractStartDate, lastDayPaid)
+
+		// A contract ending during the month is not also suspended, and a
+		// contract is suspended for at most one reason
+		var stoppage *WorkStoppage
+		var suspension *Suspension
+		var therapeutic *TherapeuticPartTime
+		if contractEnd == nil {
+			switch {
+			case chance(workStoppageShare):
+				s := GenerateWorkStoppage(contract, month, individual.Gender)
+				stoppage = &s
+			case chance(suspensionShare):
+				s := GenerateSuspension(contract, month)
+				suspension = &s
+			case chance(therapeuticPartTimeShare):
+				t := GenerateTherapeuticPartTime(contract, month, monthlySalary)
+				therapeutic = &t
+			}
+		}
 
-	writer.WriteString("S21.G00.11,''\n")
-	lines, err = Serialize(establishment)
-	if err != nil {
-		log.Fatalf("cannot serialize establishment: %v", err)
-	}
-	for _, line := range lines {
-		writer.WriteString(line)
-	}
+		// The days of an unpaid absence are not paid
+		if stoppage != nil {
+			start, end := workStoppagePeriod(*stoppage)
+			remuneration = DeductAbsence(remuneration, start, end)
+		}
+		if suspension != nil {
+			start, end := suspensionPeriod(*suspension, month)
+			remuneration = DeductAbsence(remuneration, start, end)
+		}
 
-	for range nIndividuals {
-		writer.WriteString("S21.G00.30,''\n")
-		individual := GenerateIndividual()
-		lines, err = Serialize(individual)
-		if err != nil {
-			log.Fatalf("cannot serialize individual: %v", err)
+		// The stoppage, end and suspensions of the contract are nested in it
+		if stoppage != nil {
+			contract.WorkStoppages = []WorkStoppage{*stoppage}
+		}
+		if contractEnd != nil {
+			end := *contractEnd
+			if notice != nil {
+				end.Notices = []Notice{*notice}
+			}
+			contract.Ends = []ContractEnd{end}
+		}
+		if suspension != nil {
+			contract.Suspensions = []Suspension{*suspension}
 		}
-		for _, line := range lines {
-			writer.WriteString(line)
+		if therapeutic != nil {
+			contract.TherapeuticPartTimes = []TherapeuticPartTime{*therapeutic}
 		}
+		writeBloc(writer, "S21.G00.40", contract)
 
-		writer.WriteString("S21.G00.40,''\n")
-		contract := GenerateContract()
-		lines, err = Serialize(contract)
-		if err != nil {
-			log.Fatalf("cannot serialize contract: %v", err)
+		writeBloc(writer, "S21.G00.85", workplace)
+		if userEstablishment != nil {
+			writeBloc(writer, "S21.G00.85", *userEstablishment)
 		}
-		for _, line := range lines {
-			writer.WriteString(line)
+
+		writeBloc(writer, "S21.G00.50", payment)
+		writeBloc(writer, "S21.G00.51", remuneration)
+
+		for _, bonus := range bonuses {
+			writeBloc(writer, "S21.G00.52", bonus)
 		}
 
-		writer.WriteString("S21.G00.50,''\n")
-		payment := GeneratePayment()
-		lines, err = Serialize(payment)
-		if err != nil {
-			log.Fatalf("cannot serialize payment: %v", err)
+		if stoppage != nil {
+			start, end := workStoppagePeriod(*stoppage)
+			if absence, ok := GenerateAbsenceActivity(start, end, month); ok {
+				writeBloc(writer, "S21.G00.53", absence)
+			}
 		}
-		for _, line := range lines {
-			writer.WriteString(line)
+		if suspension != nil {
+			start, end := suspensionPeriod(*suspension, month)
+			if absence, ok := GenerateAbsenceActivity(start, end, month); ok {
+				writeBloc(writer, "S21.G00.53", absence)
+			}
 		}
 
-		remuneration := GenerateRemuneration(contract.ContractNumber)
-		writer.WriteString("S21.G00.51,''\n")
-		lines, err = Serialize(remuneration)
-		if err != nil {
-			log.Fatalf("cannot serialize remuneration: %v", err)
+		var affiliation *ProvidentAffiliation
+		if chance(providentAffiliationShare) {
+			option := drawCode("S21.G00.70.004")
+			dependants := GenerateDependants(individual, contract.ContractStartDate, month, option, gofakeit.IntRange(0, maxDependants))
+			a := GenerateProvidentAffiliation(contract, contractEnd, providentMembershipID, option, dependants)
+			affiliation = &a
+			writeBloc(writer, "S21.G00.70", a)
+			for _, dependant := range dependants {
+				writeBloc(writer, "S21.G00.73", dependant)
+			}
 		}
-		for _, line := range lines {
-			writer.WriteString(line)
+
+		if pension, ok := GenerateSupplementaryPension(contract, pensionMembershipID); ok {
+			writeBloc(writer, "S21.G00.71", pension)
+
+			if contract.ContractStartDate.Before(firstDayOfMonth(month)) && chance(wrongfulPensionAffiliationShare) {
+				wrongful := GenerateWrongfulPensionAffiliation(contract, pension, month, monthlySalary)
+				writeBloc(writer, "S21.G00.72", wrongful)
+			}
+		}
+
+		for _, base := range GenerateSubjectBases(contract, remuneration, bonuses, affiliation) {
+			writeBloc(writer, "S21.G00.78", base)
+
+			organismID := urssafID
+			if base.Code == BaseProvident {
+				organismID = providentOrganismID
+			}
+			for _, contribution := range GenerateIndividualContributions(base, organismID) {
+				writeBloc(writer, "S21.G00.81", contribution)
+			}
+
+			if base.Code == BaseUncapped {
+				totalGross += base.Amount
+			}
+		}
+
+		writeBloc(writer, "S21.G00.86", GenerateSeniority(contract, month))
+
+		// The previous month was wrongly declared to the basic schemes, as if
+		// the individual was paid the same salary
+		previousMonth := firstDayOfMonth(month).AddDate(0, -1, 0)
+		if contract.ContractStartDate.Before(previousMonth) && chance(wrongfulBaseRegimeShare) {
+			previous := GenerateRemuneration(contract.ContractNumber, previousMonth)
+			previous.Amount = monthlySalary
+			for _, wrongful := range GenerateWrongfulBaseRegimeBases(GenerateSubjectBases(contract, previous, nil, nil)) {
+				writeBloc(writer, "S21.G00.95", wrongful)
+			}
+		}
+
+		if garnishment != nil {
+			writeBloc(writer, "S21.G00.98", *garnishment)
+		}
+
+		if yearEnd && chance(definedBenefitPensionShare) {
+			if right, ok := GenerateDefinedBenefitPensionRight(individual, contract, month, monthlySalary); ok {
+				pensionRights = append(pensionRights, right)
+			}
+		}
+
+		if yearEnd && chance(equityCompensationShare) {
+			switch gofakeit.IntRange(0, 2) {
+			case 0:
+				if grant, ok := GenerateFreeShareGrant(individual, contract, month); ok {
+					freeShares = append(freeShares, grant)
+				}
+			case 1:
+				if grant, ok := GenerateStockOptionGrant(individual, contract, month); ok {
+					stockOptions = append(stockOptions, grant)
+				}
+			case 2:
+				if grant, ok := GenerateWarrantGrant(individual, contract, month); ok {
+					warrants = append(warrants, grant)
+				}
+			}
+		}
+	}
+
+	for _, contribution := range GenerateEstablishmentContributions(establishment, totalGross, month) {
+		writeBloc(writer, "S21.G00.82", contribution)
+	}
+
+	if yearEnd {
+		for range nFeeBeneficiaries {
+			beneficiary := GenerateFeeBeneficiary(month)
+			writeBloc(writer, "S89.G00.32", beneficiary)
+		}
+
+		for _, right := range pensionRights {
+			writeBloc(writer, "S89.G00.67", right)
+		}
+		for _, grant := range freeShares {
+			writeBloc(writer, "S89.G00.87", grant)
+		}
+		for _, grant := range stockOptions {
+			writeBloc(writer, "S89.G00.88", grant)
+		}
+		for _, grant := range warrants {
+			writeBloc(writer, "S89.G00.89", grant)
 		}
 	}
 
+	for range nNonSalaried {
+		officer := GenerateNonSalariedIndividual(month)
+		writeBloc(writer, "S89.G00.91", officer)
+	}
+
+	// The total counts its own rubrics
+	writeBloc(writer, "S90.G00.90", Total{RubricCount: writer.rubrics + 2, DeclarationCount: 1})
+	if writer.err != nil {
+		return writer.err
+	}
+	return writer.Flush()
+}
+
+func main() {
+	yearEnd := flag.Bool("year-end", false, "also generate the S89 blocs of the year-end declaration")
+	latin1 := flag.Bool("latin1", false, "encode the DSN file in ISO-8859-1 instead of UTF-8")
+	flag.Parse()
+
+	if flag.Arg(0) == "validate" {
+		path := flag.Arg(1)
+		if path == "" {
+			path = "dsn.txt"
+		}
+		validateFile(path, *latin1)
+		return
+	}
+
+	_, err := os.OpenFile("dsn.txt", os.O_RDONLY, 0644)
+	if err == nil {
+		err = os.Remove("dsn.txt")
+		if err != nil {
+			log.Fatalf("could not delete DSN file: %v", err)
+		}
+	}
+
+	file, err := os.OpenFile("dsn.txt", os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	var output io.Writer = file
+	if *latin1 {
+		output = &latin1Writer{w: output}
+	}
+	if err := generateDSN(output, *yearEnd); err != nil {
+		log.Fatal(err)
+	}
+
 	log.Printf("Done writing the file: %s", file.Name())
 }
 
-func sample(s []string) string {
-	return s[gofakeit.IntRange(0, len(s)-1)]
+// roundAmount rounds an amount to the cent, so that the totals computed from
+// declared amounts match the sum of their serialized values
+func roundAmount(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+// roundRate rounds a rate to the precision of FormatRate, so that the amounts
+// computed from a declared rate can be checked against it
+func roundRate(rate float64) float64 {
+	return math.Round(rate*1000) / 1000
+}
+
+// chance returns true with probability p
+func chance(p float64) bool {
+	return gofakeit.Float64Range(0, 1) < p
 }
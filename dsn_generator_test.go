@@ -0,0 +1,195 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatNumber(t *testing.T) {
+	tests := []struct {
+		name    string
+		f       float64
+		format  string
+		want    string
+		wantErr bool
+	}{
+		{name: "amount", f: 1234.5, format: FormatAmount, want: "1234.50"},
+		{name: "amount rounded to the cent", f: 10.456, format: FormatAmount, want: "10.46"},
+		{name: "negative amount", f: -42.1, format: FormatAmount, want: "-42.10"},
+		{name: "negative amount rounded to zero", f: -0.001, format: FormatAmount, want: "0.00"},
+		{name: "rate", f: 0.5, format: FormatRate, want: "0.500"},
+		{name: "rate rounded to three decimals", f: 6.0456, format: FormatRate, want: "6.046"},
+		{name: "quotity", f: 151.666667, format: FormatQuotity, want: "151.67"},
+		{name: "integer", f: 35, format: FormatInteger, want: "35"},
+		{name: "integer rounded", f: 12.6, format: FormatInteger, want: "13"},
+		{name: "negative integer", f: -3, format: FormatInteger, want: "-3"},
+		{name: "missing format", f: 1, format: "", wantErr: true},
+		{name: "unknown format", f: 1, format: "percent", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := formatNumber(tt.f, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("formatNumber() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("formatNumber() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSerialize(t *testing.T) {
+	type notice struct {
+		Type  string    `dsn:"S21.G00.63.001"`
+		Start time.Time `dsn:"S21.G00.63.002"`
+	}
+
+	tests := []struct {
+		name    string
+		v       interface{}
+		want    []string
+		wantErr string
+	}{
+		{
+			name: "bool",
+			v: struct {
+				Yes bool `dsn:"S21.G00.40.044"`
+				No  bool `dsn:"S21.G00.40.045"`
+			}{Yes: true},
+			want: []string{"S21.G00.40.044,'01'\n", "S21.G00.40.045,'02'\n"},
+		},
+		{
+			name: "uint",
+			v: struct {
+				Count uint `dsn:"S90.G00.90.001"`
+			}{Count: 42},
+			want: []string{"S90.G00.90.001,'42'\n"},
+		},
+		{
+			name: "DSNMarshaler",
+			v: struct {
+				SIRET SIRET `dsn:"S20.G00.05.012"`
+			}{SIRET{SIREN: "123456789", NIC: "00012"}},
+			want: []string{"S20.G00.05.012,'12345678900012'\n"},
+		},
+		{
+			name: "DSNMarshaler error",
+			v: struct {
+				SIRET SIRET `dsn:"S20.G00.05.012"`
+			}{SIRET{SIREN: "1234", NIC: "00012"}},
+			wantErr: "invalid SIRET",
+		},
+		{
+			name: "nested blocs after the rubrics",
+			v: struct {
+				Notices []notice  `dsn:"S21.G00.63"`
+				Date    time.Time `dsn:"S21.G00.62.001"`
+			}{
+				Notices: []notice{{"01", time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)}},
+				Date:    time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+			},
+			want: []string{
+				"S21.G00.62.001,'20250331'\n",
+				"S21.G00.63,''\n",
+				"S21.G00.63.001,'01'\n",
+				"S21.G00.63.002,'20250301'\n",
+			},
+		},
+		{
+			name: "slice tagged with a rubric",
+			v: struct {
+				Dates []time.Time `dsn:"S21.G00.62.001"`
+			}{Dates: []time.Time{time.Now()}},
+			wantErr: "unsupported kind slice",
+		},
+		{
+			name: "bloc tag on a single struct",
+			v: struct {
+				Notice notice `dsn:"S21.G00.63"`
+			}{},
+			wantErr: "not a slice of structs",
+		},
+		{
+			name: "empty optional rubrics",
+			v: struct {
+				Name    string    `dsn:"S21.G00.30.003,omitempty"`
+				EndDate time.Time `dsn:"S21.G00.40.010,omitempty"`
+				Email   *string   `dsn:"S21.G00.30.018,omitempty"`
+				Count   int       `dsn:"S90.G00.90.001,omitempty"`
+			}{},
+			want: []string{"S90.G00.90.001,'0'\n"},
+		},
+		{
+			name: "empty mandatory rubric",
+			v: struct {
+				Name string `dsn:"S21.G00.30.002"`
+			}{},
+			wantErr: "mandatory rubric Name is empty",
+		},
+		{
+			name: "empty mandatory date",
+			v: struct {
+				EndDate time.Time `dsn:"S21.G00.40.010"`
+			}{},
+			wantErr: "mandatory rubric EndDate is empty",
+		},
+		{
+			name: "upper",
+			v: struct {
+				LastName   string `dsn:"S21.G00.30.002,upper"`
+				FirstNames string `dsn:"S21.G00.30.004"`
+			}{LastName: "d’Hérouville", FirstNames: "Zoé"},
+			want: []string{"S21.G00.30.002,'D''HÉROUVILLE'\n", "S21.G00.30.004,'Zoé'\n"},
+		},
+		{
+			name: "mandatory rubric emptied by the sanitization",
+			v: struct {
+				LastName string `dsn:"S21.G00.30.002,upper"`
+			}{LastName: "李"},
+			wantErr: "mandatory rubric LastName is empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Serialize(tt.v)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Serialize() error = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Serialize() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Serialize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteBlocKeepsTheFirstError(t *testing.T) {
+	var buf bytes.Buffer
+	writer := &dsnWriter{Writer: bufio.NewWriter(&buf)}
+
+	// A name in a script outside of the DSN character set is emptied
+	individual := GenerateIndividual()
+	individual.LastName = "李"
+	writeBloc(writer, "S21.G00.30", individual)
+	writeBloc(writer, "S90.G00.90", Total{RubricCount: 2, DeclarationCount: 1})
+	writer.Flush()
+
+	if writer.err == nil || !strings.Contains(writer.err.Error(), "mandatory rubric LastName is empty") {
+		t.Errorf("writer.err = %v, want the empty last name", writer.err)
+	}
+	if buf.Len() != 0 || writer.rubrics != 0 {
+		t.Errorf("written %q and %d rubrics after the error, want nothing", buf.String(), writer.rubrics)
+	}
+}
@@ -0,0 +1,605 @@
+package main
+
+import (
+	"log"
+	"slices"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+// Code represents an allowed value of a coded DSN attribute with its label.
+// Weight is the relative frequency of the code in real declarations.
+type Code struct {
+	Value  string
+	Label  string
+	Weight int
+}
+
+// Nomenclature represents the official list of the codes of a DSN attribute
+type Nomenclature []Code
+
+var yesNoCodes = Nomenclature{
+	{Yes, "Oui", 0},
+	{No, "Non", 0},
+}
+
+var genderCodes = Nomenclature{
+	{Male, "Masculin", 0},
+	{Female, "Féminin", 0},
+}
+
+// baseSchemeCodes are the codes of the basic schemes of the social security
+var baseSchemeCodes = Nomenclature{
+	{"200", "Régime général", 95},
+	{"300", "Régime agricole", 5},
+}
+
+var withholdingTaxRateTypeCodes = Nomenclature{
+	{WithholdingTaxRateTransmitted, "Taux transmis par la DGFiP", 85},
+	{"13", "Barème de droit commun métropole", 13},
+	{"14", "Barème Guadeloupe, Réunion, Martinique", 1},
+	{"15", "Barème Guyane, Mayotte", 1},
+}
+
+var pensionSchemeCodes = Nomenclature{
+	{PensionSchemeAgircArrco, "Agirc-Arrco", 97},
+	{PensionSchemeIRCANTEC, "Ircantec", 2},
+	{PensionSchemeCRPNPAC, "Personnel navigant de l'aéronautique civile", 1},
+}
+
+var subjectBaseCodes = Nomenclature{
+	{BaseCapped, "Assiette brute plafonnée", 0},
+	{BaseUncapped, "Assiette brute déplafonnée", 0},
+	{BaseCSG, "Assiette de la contribution sociale généralisée", 0},
+	{BaseUnemployment, "Assiette des contributions d'Assurance chômage", 0},
+	{BaseProvident, "Eléments de cotisation de prévoyance, santé, retraite supplémentaire", 0},
+}
+
+// legalNatureCodes are the legal natures of an employer or a workplace
+var legalNatureCodes = Nomenclature{
+	{"01", "Employeur de droit privé", 95},
+	{"02", "Etablissement public à caractère industriel et commercial", 2},
+	{"03", "Etablissement public à caractère administratif", 1},
+	{"04", "Collectivité territoriale", 1},
+	{"05", "Administration de l'Etat", 1},
+}
+
+// educationLevelCodes are the levels of the diplomas, from the census of the
+// INSEE
+var educationLevelCodes = Nomenclature{
+	{"01", "Aucun diplôme", 10},
+	{"02", "Certificat d'études primaires", 2},
+	{"03", "BEPC, brevet élémentaire, brevet des collèges, DNB", 6},
+	{"04", "CAP, BEP ou diplôme de niveau équivalent", 20},
+	{"05", "Baccalauréat, brevet professionnel ou diplôme de niveau équivalent", 20},
+	{"06", "Capacité en droit, DAEU, ESEU", 1},
+	{"07", "BTS, DUT, DEUG, DEUST ou diplôme de niveau bac+2", 16},
+	{"08", "Licence, licence professionnelle, maîtrise ou diplôme de niveau bac+3 ou bac+4", 12},
+	{"09", "Master, DEA, DESS, diplôme de grande école de niveau bac+5, doctorat de santé", 12},
+	{"10", "Doctorat de recherche (hors santé)", 1},
+}
+
+// employeeStatusCodes are the statuses of an employee under the collective
+// agreement
+var employeeStatusCodes = Nomenclature{
+	{"01", "Agriculteur salarié de son exploitation", 1},
+	{"02", "Artisan ou commerçant salarié de son entreprise", 1},
+	{"03", "Cadre dirigeant", 2},
+	{"04", "Autres cadres au sens de la convention collective", 15},
+	{"05", "Profession intermédiaire", 20},
+	{"06", "Employé administratif d'entreprise, de commerce, agent de service", 36},
+	{"07", "Ouvriers qualifiés et non qualifiés y compris ouvriers agricoles", 25},
+	{"08", "Agent de la fonction publique d'Etat", 0},
+	{"09", "Agent de la fonction publique hospitalière", 0},
+	{"10", "Agent de la fonction publique territoriale", 0},
+}
+
+// collectiveAgreementCodes are the IDCC of the most common collective
+// agreements
+var collectiveAgreementCodes = Nomenclature{
+	{"1486", "Bureaux d'études techniques, cabinets d'ingénieurs-conseils et sociétés de conseils (Syntec)", 20},
+	{"3248", "Métallurgie", 15},
+	{"2216", "Commerce de détail et de gros à prédominance alimentaire", 15},
+	{"1979", "Hôtels, cafés, restaurants", 10},
+	{"1597", "Bâtiment ouvriers (plus de 10 salariés)", 10},
+	{"0016", "Transports routiers et activités auxiliaires du transport", 8},
+	{"0044", "Industries chimiques", 5},
+	{"0573", "Commerces de gros", 7},
+	{"9999", "Sans convention collective", 10},
+}
+
+// providentOptionCodes are the options of the provident plan of the company,
+// chosen by each affiliated employee
+var providentOptionCodes = Nomenclature{
+	{"BASE", "Formule de base", 60},
+	{"OPT1", "Option 1", 30},
+	{"OPT2", "Option 2", 10},
+}
+
+// Nomenclatures maps the coded attributes to their official codes. Codes
+// without a weight are never drawn when other codes of the list have one,
+// e.g. the natures of the declaration other than the monthly DSN.
+var Nomenclatures = map[AttributeID]Nomenclature{
+	"S10.G00.00.005": {
+		{"01", "Essai", 0},
+		{"02", "Réel", 0},
+	},
+	"S10.G00.00.007": {
+		{"01", "Net-entreprises", 9},
+		{"02", "MSA", 1},
+	},
+	"S10.G00.00.008": {
+		{"01", "Envoi normal", 99},
+		{"02", "Envoi complémentaire", 1},
+	},
+	"S10.G00.02.001": {
+		{"01", "Monsieur", 0},
+		{"02", "Madame", 0},
+	},
+	"S20.G00.05.001": {
+		{"01", "DSN mensuelle", 1},
+		{"02", "Signalement Fin du contrat de travail", 0},
+		{"04", "Signalement Arrêt de travail", 0},
+		{"05", "Signalement Reprise suite à arrêt de travail", 0},
+		{"07", "Signalement Fin du contrat de travail unique", 0},
+	},
+	"S20.G00.05.002": {
+		{"01", "Déclaration normale", 95},
+		{"02", "Déclaration normale néant", 0},
+		{"03", "Déclaration annule et remplace intégral", 5},
+		{"04", "Déclaration annule", 0},
+		{"05", "Annule et remplace néant", 0},
+	},
+	"S20.G00.05.008": {
+		{"01", "Déclaration de l'ensemble des salariés de l'établissement", 1},
+		{"02", "Déclaration d'une partie des salariés de l'établissement", 0},
+	},
+	"S20.G00.05.010": {
+		{"01", "Euro", 0},
+	},
+	"S20.G00.05.011": {
+		{"01", "Arrêt de travail", 0},
+		{"02", "Reprise suite à arrêt de travail", 0},
+		{"03", "Fin du contrat de travail", 0},
+	},
+	"S20.G00.05.013": {
+		{"01", "Substitution d'une DSN mensuelle", 0},
+		{"02", "Substitution d'un signalement Fin du contrat de travail", 0},
+		{"03", "Substitution d'un signalement Arrêt de travail", 0},
+	},
+	"S21.G00.06.012": {
+		{"01", "Entreprise établie en France", 97},
+		{"02", "Entreprise étrangère sans établissement en France", 3},
+	},
+	"S21.G00.06.015": collectiveAgreementCodes,
+	"S21.G00.11.009": {
+		{"01", "Rémunération réelle", 0},
+		{"02", "Rémunération forfaitaire", 0},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.11.017": legalNatureCodes,
+	"S21.G00.11.022": collectiveAgreementCodes,
+	"S21.G00.11.023": {
+		{"01", "AFDAS", 1},
+		{"02", "ATLAS", 10},
+		{"03", "Uniformation", 3},
+		{"04", "OCAPIAT", 1},
+		{"05", "OPCO 2i", 15},
+		{"06", "Constructys", 8},
+		{"07", "OPCO Mobilités", 6},
+		{"08", "AKTO", 15},
+		{"09", "OPCO EP", 20},
+		{"10", "OPCO Santé", 6},
+		{"11", "L'Opcommerce", 15},
+	},
+	"S21.G00.11.024": {
+		{Yes, "Oui", 0},
+		{No, "Non", 1},
+	},
+	"S21.G00.13.001": yesNoCodes,
+	"S21.G00.13.002": {
+		{ExternalBOETHTrainee, "Stagiaire", 0},
+		{ExternalBOETHPMSMP, "Période de mise en situation en milieu professionnel", 0},
+		{ExternalBOETHSeconded, "Salarié mis à disposition par une entreprise de travail temporaire ou un groupement d'employeurs", 0},
+	},
+	"S21.G00.30.005": genderCodes,
+	"S21.G00.30.013": {
+		{"01", "Ressortissant français", 85},
+		{"02", "Ressortissant de l'Union européenne", 8},
+		{"03", "Ressortissant hors Union européenne", 7},
+	},
+	"S21.G00.30.022": {
+		{"01", "Salarié non résident fiscal en France", 2},
+		{"02", "Salarié frontalier résident fiscal en France", 1},
+		{"99", "Salarié non concerné", 97},
+	},
+	"S21.G00.30.023": {
+		{"01", "Cumul emploi retraite", 3},
+		{"02", "Retraite progressive", 1},
+		{"99", "Salarié non concerné", 96},
+	},
+	"S21.G00.30.024": educationLevelCodes,
+	"S21.G00.30.025": append(slices.Clone(educationLevelCodes), Code{"99", "Pas de diplôme en préparation", 900}),
+	"S21.G00.40.002": employeeStatusCodes,
+	"S21.G00.40.003": {
+		{PensionCategoryExecutive, "Cadre (articles 4 et 4bis de la convention AGIRC de 1947)", 1},
+		{PensionCategoryExecutiveExtension, "Extension cadre pour retraite complémentaire (article 36)", 1},
+		{PensionCategoryNonExecutive, "Non cadre", 3},
+		{PensionCategoryOther, "Retraite complémentaire ne relevant pas de l'Agirc-Arrco", 1},
+		{PensionCategoryNone, "Pas de retraite complémentaire", 0},
+	},
+	// A sample of the PCS-ESE, spread over the statuses of S21.G00.40.002
+	"S21.G00.40.004": {
+		{"231a", "Chefs de grande entreprise de 500 salariés et plus", 1},
+		{"372a", "Cadres chargés d'études économiques, financières, commerciales", 8},
+		{"388a", "Ingénieurs et cadres d'étude, recherche et développement en informatique", 8},
+		{"461a", "Personnels de secrétariat de niveau supérieur, secrétaires de direction", 6},
+		{"478a", "Techniciens d'étude et de développement en informatique", 14},
+		{"542a", "Secrétaires", 12},
+		{"543d", "Employés administratifs qualifiés des autres services des entreprises", 14},
+		{"551a", "Employés de libre service du commerce et magasiniers", 12},
+		{"628a", "Mécaniciens qualifiés de maintenance, entretien : équipements industriels", 13},
+		{"676a", "Manutentionnaires non qualifiés", 12},
+	},
+	// The complements refine the PCS-ESE in the sectors which define them
+	"S21.G00.40.005": {
+		{"01", "Complément sectoriel 01", 1},
+		{"02", "Complément sectoriel 02", 1},
+		{"03", "Complément sectoriel 03", 1},
+	},
+	"S21.G00.40.007": {
+		{ContractPermanent, "Contrat de travail à durée indéterminée de droit privé", 70},
+		{ContractFixedTerm, "Contrat de travail à durée déterminée de droit privé", 25},
+		{ContractTemporaryWork, "Contrat de mission (contrat de travail temporaire)", 0},
+		{"07", "Contrat à durée indéterminée intermittente", 2},
+		{"09", "Contrat à durée indéterminée de droit public", 0},
+		{"10", "Contrat à durée déterminée de droit public", 0},
+		{"29", "Convention de stage (hors formation professionnelle)", 3},
+		{"80", "Mandat social", 0},
+		{"90", "Autre nature de contrat, convention, mandat", 0},
+	},
+	"S21.G00.40.008": {
+		{"21", "CUI - Contrat Initiative Emploi", 0},
+		{"41", "CUI - Contrat d'Accompagnement dans l'Emploi", 0},
+		{"61", "Contrat de professionnalisation", 4},
+		{"64", "Contrat d'apprentissage entreprises artisanales ou de moins de 11 salariés", 3},
+		{"65", "Contrat d'apprentissage entreprises non inscrites au répertoire des métiers d'au moins 11 salariés", 3},
+		{"99", "Non concerné", 90},
+	},
+	"S21.G00.40.011": {
+		{MeasurementUnitHour, "Heure", 80},
+		{"12", "Journée", 2},
+		{"20", "Forfait jour", 15},
+		{"21", "Forfait heure", 3},
+		{"99", "Salarié non concerné", 0},
+	},
+	"S21.G00.40.014": {
+		{"10", "Temps plein", 80},
+		{"20", "Temps partiel", 20},
+		{"21", "Temps partiel thérapeutique", 0},
+		{"99", "Salarié non concerné", 0},
+	},
+	"S21.G00.40.016": {
+		{"01", "Régime local Alsace-Moselle", 3},
+		{"99", "Non concerné", 97},
+	},
+	"S21.G00.40.017": collectiveAgreementCodes,
+	"S21.G00.40.018": baseSchemeCodes,
+	"S21.G00.40.020": baseSchemeCodes,
+	"S21.G00.40.021": {
+		{"01", "Remplacement d'un salarié", 0},
+		{"02", "Accroissement temporaire de l'activité de l'entreprise", 0},
+		{"03", "Emplois à caractère saisonnier", 0},
+	},
+	"S21.G00.40.022": {
+		{"01", "Caisse de congés payés du bâtiment et des travaux publics", 3},
+		{"02", "Caisse des congés spectacles", 1},
+		{"03", "Caisse de congés payés des transports", 0},
+		{"04", "Caisse de congés payés des dockers", 0},
+		{"90", "Pas de caisse professionnelle de congés payés", 96},
+	},
+	"S21.G00.40.024": {
+		{"01", "Salarié détaché à l'étranger", 0},
+		{"02", "Salarié expatrié", 0},
+		{"03", "Frontalier", 0},
+		{"99", "Salarié non concerné", 1},
+	},
+	"S21.G00.40.025": {
+		{"01", "Intermittent du spectacle déclaré au GUSO", 0},
+		{"02", "Salarié déclaré au TESA", 0},
+		{"03", "Salarié déclaré au CESU ou à Pajemploi", 0},
+		{"99", "Pas d'exclusion", 1},
+	},
+	"S21.G00.40.026": {
+		{"01", "Fonctionnaire titulaire", 0},
+		{"02", "Fonctionnaire stagiaire", 0},
+		{"03", "Agent contractuel de droit public", 0},
+		{"04", "Ouvrier de l'Etat", 0},
+		{"99", "Salarié de droit privé", 1},
+	},
+	"S21.G00.40.027": {
+		{"01", "Régime d'Assurance chômage géré par France Travail", 95},
+		{"02", "Auto-assurance de l'employeur public", 1},
+		{"03", "Convention de gestion avec France Travail", 1},
+		{"04", "Adhésion révocable", 1},
+		{"05", "Adhésion irrévocable", 1},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.40.029": {
+		{"01", "Contributions versées à l'Urssaf", 95},
+		{"02", "Contributions versées à France Travail", 5},
+		{"99", "Non concerné", 0},
+	},
+	"S21.G00.40.036": {
+		{"01", "Emplois multiples", 1},
+		{"02", "Emploi unique", 8},
+		{"03", "Information non connue", 1},
+	},
+	"S21.G00.40.037": {
+		{"01", "Employeurs multiples", 1},
+		{"02", "Employeur unique", 8},
+		{"03", "Information non connue", 1},
+	},
+	"S21.G00.40.039": baseSchemeCodes,
+	"S21.G00.40.042": {
+		{"01", "Ingénieurs et cadres", 0},
+		{"02", "Techniciens et agents de maîtrise", 0},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.40.044": yesNoCodes,
+	"S21.G00.40.045": yesNoCodes,
+	"S21.G00.40.051": {
+		{"01", "Organisateur de spectacles à titre principal", 0},
+		{"02", "Organisateur de spectacles à titre occasionnel", 0},
+		{"99", "Non concerné", 1},
+	},
+	// A sample of the jobs of the state public service (NNE)
+	"S21.G00.40.052": {
+		{"0001", "Emploi NNE 0001", 1},
+		{"0002", "Emploi NNE 0002", 1},
+		{"0003", "Emploi NNE 0003", 1},
+	},
+	"S21.G00.40.053": {
+		{"01", "Emploi permanent", 7},
+		{"02", "Emploi non permanent", 3},
+	},
+	"S21.G00.40.056": {
+		{"01", "Catégorie active", 0},
+		{"02", "Catégorie sédentaire", 0},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.40.062": {
+		{"01", "Fonction publique d'Etat", 0},
+		{"02", "Fonction publique hospitalière", 0},
+		{"03", "Fonction publique territoriale", 0},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.40.065": {
+		{Yes, "Oui", 0},
+		{No, "Non", 1},
+	},
+	"S21.G00.40.066": {
+		{"01", "Détachement sur contrat", 0},
+		{"02", "Détachement sur emploi", 0},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.40.067": {
+		{"01", "Long cours", 0},
+		{"02", "Cabotage", 0},
+		{"03", "Navigation côtière", 0},
+		{"04", "Pêche", 0},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.40.072": {
+		{DisabledWorkerRQTH, "Reconnaissance de la qualité de travailleur handicapé", 2},
+		{DisabledWorkerWorkAccident, "Victime d'accident du travail ou de maladie professionnelle", 1},
+		{DisabledWorkerInvalidity, "Titulaire d'une pension d'invalidité", 1},
+		{DisabledWorkerDisabilityCard, "Titulaire de la carte d'invalidité ou de la carte mobilité inclusion", 1},
+	},
+	"S21.G00.40.073": {
+		{"01", "Emploi franc", 1},
+		{"02", "Contrat de professionnalisation expérimental", 1},
+		{"99", "Non concerné", 98},
+	},
+	"S21.G00.40.074": {
+		{"01", "Mise à disposition à but non lucratif", 0},
+		{"02", "Mise à disposition au sein d'un groupement d'employeurs", 0},
+		{"03", "Mise à disposition d'un fonctionnaire", 0},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.40.075": {
+		{"01", "Cadre", 1},
+		{"02", "Non cadre", 3},
+	},
+	"S21.G00.40.077": {
+		{"01", "Exécution", 0},
+		{"02", "Maîtrise", 0},
+		{"03", "Cadre", 0},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.40.078": {
+		{"01", "Réduction de l'horaire de travail", 0},
+		{"02", "Fermeture temporaire de l'établissement", 0},
+		{"99", "Non concerné", 1},
+	},
+	"S21.G00.50.007": withholdingTaxRateTypeCodes,
+	"S21.G00.51.011": {
+		{"012", "Heures d'équivalence", 1},
+		{"013", "Heures d'habillage, déshabillage, pause", 1},
+		{"017", "Heures supplémentaires ou complémentaires aléatoires", 1},
+		{"018", "Heures supplémentaires structurelles", 1},
+	},
+	"S21.G00.52.001": {
+		{IndemnityPaidLeave, "Indemnité compensatrice de congés payés", 0},
+		{IndemnityNotice, "Indemnité compensatrice de préavis", 0},
+		{IndemnityDismissal, "Indemnité légale ou conventionnelle de licenciement", 0},
+		{IndemnityConventionalBreach, "Indemnité spécifique de rupture conventionnelle", 0},
+	},
+	"S21.G00.53.001": {
+		{ActivityPaidWork, "Travail rémunéré", 1},
+		{ActivityUnpaidAbsence, "Absence non rémunérée", 0},
+	},
+	"S21.G00.53.003": {
+		{MeasurementUnitHour, "Heure", 1},
+		{"12", "Journée", 0},
+	},
+	"S21.G00.60.001": {
+		{StoppageSickness, "Maladie", 6},
+		{StoppageMaternity, "Maternité", 2},
+		{StoppagePaternity, "Paternité / accueil de l'enfant", 1},
+		{StoppageCommutingAccident, "Congé suite à un accident de trajet", 1},
+		{StoppageOccupationalIll, "Congé suite à maladie professionnelle", 1},
+		{StoppageWorkAccident, "Congé suite à accident de travail ou de service", 2},
+	},
+	"S21.G00.60.004": yesNoCodes,
+	"S21.G00.60.011": {
+		{"01", "Reprise normale", 90},
+		{"02", "Reprise à temps partiel thérapeutique", 9},
+		{"03", "Reprise à temps partiel pour raison médicale", 1},
+	},
+	"S21.G00.62.002": {
+		{EndDismissalEconomic, "Licenciement pour motif économique", 1},
+		{EndDismissalOther, "Licenciement pour autre motif", 2},
+		{EndFixedTerm, "Fin de contrat à durée déterminée", 4},
+		{EndTrialByEmployer, "Fin de période d'essai à l'initiative de l'employeur", 1},
+		{EndTrialByEmployee, "Fin de période d'essai à l'initiative du salarié", 1},
+		{EndRetirement, "Départ à la retraite à l'initiative du salarié", 1},
+		{EndConventionalBreach, "Rupture conventionnelle", 2},
+		{EndResignation, "Démission", 4},
+	},
+	"S21.G00.62.008": yesNoCodes,
+	"S21.G00.62.014": {
+		{"01", "Salarié protégé", 2},
+		{"99", "Pas de statut particulier", 98},
+	},
+	"S21.G00.62.016": yesNoCodes,
+	"S21.G00.63.001": {
+		{NoticeWorkedPaid, "Préavis effectué et payé", 0},
+		{NoticeNotWorkedPaid, "Préavis non effectué et payé", 0},
+	},
+	"S21.G00.65.001": {
+		{SuspensionUnpaidLeave, "Congé divers non rémunéré", 0},
+		{SuspensionParentalLeave, "Congé parental d'éducation", 0},
+		{SuspensionBusinessCreation, "Congé pour la création d'entreprise", 0},
+		{SuspensionSabbatical, "Congé sabbatique", 0},
+		{SuspensionLeaveWithoutPay, "Congé sans solde", 0},
+	},
+	"S21.G00.70.004": providentOptionCodes,
+	"S21.G00.71.002": pensionSchemeCodes,
+	"S21.G00.72.001": pensionSchemeCodes,
+	"S21.G00.73.001": yesNoCodes,
+	"S21.G00.73.002": providentOptionCodes,
+	"S21.G00.73.003": {
+		{DependantSpouse, "Conjoint", 1},
+		{DependantChild, "Enfant", 3},
+		{DependantOther, "Autre (ascendant, collatéral...)", 0},
+		{DependantPartner, "Concubin ou partenaire de PACS", 1},
+	},
+	"S21.G00.78.001": subjectBaseCodes,
+	"S21.G00.84.001": subjectBaseCodes,
+	"S21.G00.85.010": legalNatureCodes,
+	"S21.G00.95.001": subjectBaseCodes,
+	"S89.G00.33.001": {
+		{BenefitFood, "Nourriture", 0},
+		{BenefitHousing, "Logement", 0},
+		{BenefitCar, "Voiture", 0},
+		{BenefitOther, "Autres avantages", 0},
+		{BenefitTelecom, "Outils issus des nouvelles technologies de l'information et de la communication", 0},
+	},
+	"S89.G00.35.001": {
+		{ExpenseFlatAllowance, "Allocation forfaitaire", 0},
+		{ExpenseReimbursement, "Remboursement", 0},
+		{ExpenseDirectPayment, "Prise en charge directe par l'employeur", 0},
+	},
+	"S89.G00.43.001": {
+		{FeeHonoraria, "Honoraires", 0},
+		{FeeCommissions, "Commissions", 0},
+		{FeeBrokerage, "Courtages", 0},
+		{FeeRebates, "Ristournes", 0},
+		{FeeAttendance, "Jetons de présence", 0},
+		{FeeCopyright, "Droits d'auteur", 0},
+		{FeeInventorRights, "Droits d'inventeur", 0},
+		{FeeOtherRemuneration, "Autres rémunérations", 0},
+	},
+	"S89.G00.91.005": genderCodes,
+	"S89.G00.91.017": employeeStatusCodes,
+	"S89.G00.92.008": withholdingTaxRateTypeCodes,
+}
+
+// The schema of a coded attribute only accepts the codes of its nomenclature
+func init() {
+	for id, nomenclature := range Nomenclatures {
+		attribute := GetAttribute(id)
+		attribute.Schema.Codes = nomenclature.Values()
+		Attributes[id] = attribute
+	}
+}
+
+// GetNomenclature returns the Nomenclature for a given AttributeID
+func GetNomenclature(id AttributeID) Nomenclature {
+	nomenclature, ok := Nomenclatures[id]
+	if !ok {
+		log.Fatalf("cannot find nomenclature: %v", id)
+	}
+	return nomenclature
+}
+
+// Values returns the values of the codes of the Nomenclature
+func (n Nomenclature) Values() []string {
+	values := make([]string, len(n))
+	for i, code := range n {
+		values[i] = code.Value
+	}
+	return values
+}
+
+// Label returns the label of value, ok is false when value is not a code of
+// the Nomenclature
+func (n Nomenclature) Label(value string) (label string, ok bool) {
+	for _, code := range n {
+		if code.Value == value {
+			return code.Label, true
+		}
+	}
+	return "", false
+}
+
+// Only returns the codes of the Nomenclature among values
+func (n Nomenclature) Only(values ...string) Nomenclature {
+	return slices.DeleteFunc(slices.Clone(n), func(code Code) bool {
+		return !slices.Contains(values, code.Value)
+	})
+}
+
+// Except returns the codes of the Nomenclature other than values
+func (n Nomenclature) Except(values ...string) Nomenclature {
+	return slices.DeleteFunc(slices.Clone(n), func(code Code) bool {
+		return slices.Contains(values, code.Value)
+	})
+}
+
+// Draw returns the value of a code of the Nomenclature drawn according to the
+// weights of the codes, or uniformly when none of them has a weight
+func (n Nomenclature) Draw() string {
+	total := 0
+	for _, code := range n {
+		total += code.Weight
+	}
+	if total == 0 {
+		return n[gofakeit.IntRange(0, len(n)-1)].Value
+	}
+
+	r := gofakeit.IntRange(0, total-1)
+	for _, code := range n {
+		if r < code.Weight {
+			return code.Value
+		}
+		r -= code.Weight
+	}
+	return n[len(n)-1].Value
+}
+
+// drawCode returns a code of the nomenclature of the attribute id
+func drawCode(id AttributeID) string {
+	return GetNomenclature(id).Draw()
+}
@@ -0,0 +1,193 @@
+package main
+
+// This file generates the S89 blocs of the company officers who are not
+// employees, and are declared alongside the individuals of the S21 section.
+
+import (
+	"math"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+// NonSalariedIndividual represents a company officer who is not an employee
+// French: Individu non salarié
+type NonSalariedIndividual struct {
+	NIR                  string                         `dsn:"S89.G00.91.001"`           // Numéro d'inscription au répertoire
+	LastName             string                         `dsn:"S89.G00.91.002,upper"`     // Nom de famille
+	UsageName            string                         `dsn:"S89.G00.91.003,upper"`     // Nom d'usage
+	FirstNames           string                         `dsn:"S89.G00.91.004,upper"`     // Prénoms
+	Gender               string                         `dsn:"S89.G00.91.005"`           // Sexe
+	BirthDate            time.Time                      `dsn:"S89.G00.91.006"`           // Date de naissance
+	BirthPlace           string                         `dsn:"S89.G00.91.007"`           // Lieu de naissance
+	StreetAddress        string                         `dsn:"S89.G00.91.008"`           // Numéro, extension, nature et libellé de la voie
+	PostalCode           string                         `dsn:"S89.G00.91.009"`           // Code postal
+	City                 string                         `dsn:"S89.G00.91.010"`           // Localité
+	CountryCode          string                         `dsn:"S89.G00.91.011,upper"`     // Code pays
+	ForeignDistribution  string                         `dsn:"S89.G00.91.012"`           // Code de distribution à l'étranger
+	BuildingComplement   string                         `dsn:"S89.G00.91.013"`           // Complément de la localisation de la construction
+	DeliveryService      string                         `dsn:"S89.G00.91.014"`           // Service de distribution, complément de localisation de la voie
+	Email                string                         `dsn:"S89.G00.91.015"`           // Adresse mél
+	CompanyID            string                         `dsn:"S89.G00.91.016"`           // Matricule de l'individu dans l'entreprise
+	EmployeeStatus       string                         `dsn:"S89.G00.91.017"`           // Statut du salarié (conventionnel)
+	PensionCategory      string                         `dsn:"S89.G00.91.018"`           // Code statut catégoriel Retraite Complémentaire obligatoire
+	BirthDepartmentCode  string                         `dsn:"S89.G00.91.019"`           // Code département de naissance
+	BirthCountryCode     string                         `dsn:"S89.G00.91.020,upper"`     // Code pays de naissance
+	TemporaryTechnicalID string                         `dsn:"S89.G00.91.021,omitempty"` // Numéro technique temporaire
+	Bases                []NonSalariedBase              `dsn:"S89.G00.92"`               // Bases spécifiques individu non salarié
+	TaxRegularizations   []NonSalariedTaxRegularization `dsn:"S89.G00.93"`               // Régularisation de prélèvement à la source
+	Contributions        []NonSalariedContribution      `dsn:"S89.G00.94"`               // Cotisation Individu non salarié
+}
+
+// NonSalariedBase represents the remuneration of a NonSalariedIndividual for
+// the declared month, with its withholding tax
+// French: Bases spécifiques individu non salarié
+type NonSalariedBase struct {
+	Type                          string    `dsn:"S89.G00.92.001"`        // Type
+	Code                          string    `dsn:"S89.G00.92.002"`        // Code de base spécifique
+	Amount                        float64   `dsn:"S89.G00.92.003,amount"` // Montant
+	PeriodStartDate               time.Time `dsn:"S89.G00.92.004"`        // Date de début de période de rattachement
+	PeriodEndDate                 time.Time `dsn:"S89.G00.92.005"`        // Date de fin de période de rattachement
+	TaxableNetIncome              float64   `dsn:"S89.G00.92.006,amount"` // Montant net fiscal du revenu versé
+	WithholdingTaxRate            float64   `dsn:"S89.G00.92.007,rate"`   // Taux de prélèvement à la source
+	WithholdingTaxRateType        string    `dsn:"S89.G00.92.008"`        // Type du taux de prélèvement à la source
+	WithholdingTaxRateID          string    `dsn:"S89.G00.92.009"`        // Identifiant du taux de prélèvement à la source
+	WithholdingTaxAmount          float64   `dsn:"S89.G00.92.010,amount"` // Montant de prélèvement à la source
+	PaymentDate                   time.Time `dsn:"S89.G00.92.011"`        // Date de versement
+	NonTaxableIncomeAmount        float64   `dsn:"S89.G00.92.012,amount"` // Montant de la part non imposable du revenu
+	AmountSubjectToWithholdingTax float64   `dsn:"S89.G00.92.013,amount"` // Montant soumis au PAS
+	TaxBaseDeductionAmount        float64   `dsn:"S89.G00.92.014,amount"` // Montant de l'abattement sur la base fiscale
+	NetAmountPaid                 float64   `dsn:"S89.G00.92.016,amount"` // Montant net versé
+	SocialNetAmount               float64   `dsn:"S89.G00.92.017,amount"` // Montant net social
+}
+
+const (
+	NonSalariedOfficerRemuneration = "01" // Rémunération de mandataire social
+
+	WithholdingTaxRateTransmitted = "01" // Taux transmis par la DGFiP
+)
+
+// NonSalariedTaxRegularization represents the correction of the withholding
+// tax rate applied to a NonSalariedIndividual in a previous month
+// French: Régularisation de prélèvement à la source
+type NonSalariedTaxRegularization struct {
+	ErrorMonth                        time.Time `dsn:"S89.G00.93.001"`        // Mois de l'erreur
+	ErrorType                         string    `dsn:"S89.G00.93.002"`        // Type d'erreur
+	TaxableNetIncomeAtError           float64   `dsn:"S89.G00.93.004,amount"` // Montant net fiscal du revenu versé le mois de l'erreur
+	WithholdingTaxRateRegularized     float64   `dsn:"S89.G00.93.005,rate"`   // Régularisation du taux de prélèvement à la source
+	WithholdingTaxRateAtError         float64   `dsn:"S89.G00.93.006,rate"`   // Taux déclaré le mois de l'erreur
+	WithholdingTaxRegularization      float64   `dsn:"S89.G00.93.007,amount"` // Montant de la régularisation du prélèvement à la source
+	AmountSubjectToWithholdingAtError float64   `dsn:"S89.G00.93.013,amount"` // Montant soumis au prélèvement à la source déclaré le mois de l'erreur
+}
+
+const (
+	TaxErrorRate = "02" // Erreur sur le taux de prélèvement à la source
+)
+
+// NonSalariedContribution represents a contribution due on the remuneration of
+// a NonSalariedIndividual
+// French: Cotisation Individu non salarié
+type NonSalariedContribution struct {
+	Code   string  `dsn:"S89.G00.94.001"`        // Code de cotisation
+	Amount float64 `dsn:"S89.G00.94.002,amount"` // Montant de cotisation
+}
+
+// employeeContributionRates lists the share of the contributions paid by the
+// officer, in percent of their base, which is deducted from the net amount
+var employeeContributionRates = map[string][]contributionRate{
+	BaseCapped:   {{"076", 6.90}},
+	BaseUncapped: {{"075", 0.40}},
+	BaseCSG:      {{"072", 6.80}, {"073", 2.90}},
+}
+
+// GenerateNonSalariedIndividual creates a new NonSalariedIndividual paid
+// during the declared month. Contributions, net amounts and withholding tax
+// are computed from the gross remuneration, and a share of the officers
+// regularize the withholding tax rate applied the previous month.
+func GenerateNonSalariedIndividual(month time.Time) NonSalariedIndividual {
+	gender := generateGender()
+	officer := NonSalariedIndividual{
+		NIR:                 generateNIR(gender),
+		LastName:            gofakeit.LastName(),
+		UsageName:           gofakeit.LastName(),
+		FirstNames:          gofakeit.FirstName(),
+		Gender:              gender,
+		BirthDate:           gofakeit.DateRange(time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(1995, 12, 31, 0, 0, 0, 0, time.UTC)),
+		BirthPlace:          gofakeit.City(),
+		StreetAddress:       gofakeit.Street(),
+		PostalCode:          gofakeit.Zip(),
+		City:                gofakeit.City(),
+		CountryCode:         "FR",
+		ForeignDistribution: gofakeit.Word(),
+		BuildingComplement:  gofakeit.Name(),
+		DeliveryService:     gofakeit.Word(),
+		Email:               gofakeit.Email(),
+		CompanyID:           gofakeit.DigitN(8),
+		// Artisan or shopkeeper at the head of their company, or executive
+		EmployeeStatus:      GetNomenclature("S89.G00.91.017").Only("02", "03").Draw(),
+		PensionCategory:     PensionCategoryExecutive,
+		BirthDepartmentCode: generateDepartmentCode(),
+		BirthCountryCode:    "FR",
+	}
+
+	gross := roundAmount(gofakeit.Float64Range(3000, 15000))
+	ceiling := socialSecurityCeiling(month)
+	bases := map[string]float64{
+		BaseCapped:   math.Min(gross, ceiling),
+		BaseUncapped: gross,
+		BaseCSG:      roundAmount(gross - math.Min(gross, 4*ceiling)*csgAllowanceRate),
+	}
+
+	for _, code := range []string{BaseCapped, BaseUncapped, BaseCSG} {
+		for _, rate := range contributionRates[code] {
+			officer.Contributions = append(officer.Contributions, NonSalariedContribution{
+				Code:   rate.Code,
+				Amount: roundAmount(bases[code] * rate.Rate / 100),
+			})
+		}
+	}
+
+	socialNet := gross
+	for code, rates := range employeeContributionRates {
+		for _, rate := range rates {
+			socialNet -= roundAmount(bases[code] * rate.Rate / 100)
+		}
+	}
+	// The non deductible CSG and CRDS are taxed
+	taxableNet := roundAmount(socialNet + bases[BaseCSG]*2.90/100)
+	taxRate := roundRate(gofakeit.Float64Range(0, 20))
+	tax := roundAmount(taxableNet * taxRate / 100)
+
+	officer.Bases = []NonSalariedBase{{
+		Type:                          NonSalariedOfficerRemuneration,
+		Code:                          BaseUncapped,
+		Amount:                        gross,
+		PeriodStartDate:               firstDayOfMonth(month),
+		PeriodEndDate:                 lastDayOfMonth(month),
+		TaxableNetIncome:              taxableNet,
+		WithholdingTaxRate:            taxRate,
+		WithholdingTaxRateType:        WithholdingTaxRateTransmitted,
+		WithholdingTaxRateID:          gofakeit.DigitN(12),
+		WithholdingTaxAmount:          tax,
+		PaymentDate:                   lastDayOfMonth(month),
+		AmountSubjectToWithholdingTax: taxableNet,
+		NetAmountPaid:                 roundAmount(socialNet - tax),
+		SocialNetAmount:               socialNet,
+	}}
+
+	// The officer was paid the same amount the previous month, with a wrong rate
+	if chance(0.2) {
+		wrongRate := roundRate(math.Max(taxRate+gofakeit.Float64Range(-5, 5), 0))
+		officer.TaxRegularizations = []NonSalariedTaxRegularization{{
+			ErrorMonth:                        firstDayOfMonth(month).AddDate(0, -1, 0),
+			ErrorType:                         TaxErrorRate,
+			TaxableNetIncomeAtError:           taxableNet,
+			WithholdingTaxRateRegularized:     taxRate,
+			WithholdingTaxRateAtError:         wrongRate,
+			WithholdingTaxRegularization:      roundAmount(taxableNet * (taxRate - wrongRate) / 100),
+			AmountSubjectToWithholdingAtError: taxableNet,
+		}}
+	}
+
+	return officer
+}
@@ -0,0 +1,196 @@
+package main
+
+// This file holds the controls between rubrics of the norm (CCH and SIG
+// controls), run by the validator once the structure of the file is read.
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// reportFunc reports an anomaly on the rubric id of an occurrence, or on the
+// occurrence itself when the rubric is missing
+type reportFunc func(id AttributeID, format string, args ...interface{})
+
+// Rule represents a control between rubrics, run on each occurrence of Bloc.
+// Check reports the anomalies of occ on its rubrics.
+type Rule struct {
+	Name  string
+	Bloc  BlocID
+	Check func(occ *Occurrence, report reportFunc)
+}
+
+// Rules lists the controls run on every DSN file
+var Rules = []Rule{
+	dateOrderRule("S21.G00.20.006", "S21.G00.20.007"),
+	dateOrderRule("S21.G00.22.003", "S21.G00.22.004"),
+	dateOrderRule("S21.G00.40.001", "S21.G00.40.010"),
+	dateOrderRule("S21.G00.40.001", "S21.G00.60.002"),
+	dateOrderRule("S21.G00.51.001", "S21.G00.51.002"),
+	dateOrderRule("S21.G00.52.003", "S21.G00.52.004"),
+	dateOrderRule("S21.G00.54.003", "S21.G00.54.004"),
+	dateOrderRule("S21.G00.58.001", "S21.G00.58.002"),
+	dateOrderRule("S21.G00.60.005", "S21.G00.60.006"),
+	dateOrderRule("S21.G00.63.002", "S21.G00.63.003"),
+	dateOrderRule("S21.G00.65.002", "S21.G00.65.003"),
+	dateOrderRule("S21.G00.66.001", "S21.G00.66.002"),
+	dateOrderRule("S21.G00.70.014", "S21.G00.70.015"),
+	dateOrderRule("S21.G00.73.004", "S21.G00.73.011"),
+	dateOrderRule("S21.G00.78.002", "S21.G00.78.003"),
+	dateOrderRule("S21.G00.82.003", "S21.G00.82.004"),
+	dateOrderRule("S21.G00.83.001", "S21.G00.83.002"),
+	dateOrderRule("S21.G00.84.002", "S21.G00.84.003"),
+	dateOrderRule("S21.G00.95.002", "S21.G00.95.003"),
+	{
+		Name:  "pay period within the declared month",
+		Bloc:  "S21.G00.51",
+		Check: checkPayPeriodInMonth,
+	},
+	{
+		Name:  "contract of the remuneration declared",
+		Bloc:  "S21.G00.51",
+		Check: checkRemunerationContract,
+	},
+	{
+		Name:  "gender matching the NIR",
+		Bloc:  "S21.G00.30",
+		Check: checkNIRGender,
+	},
+}
+
+// dateOrderRule checks that the date of the rubric end is on or after the
+// date of the rubric start, in the same bloc or in a bloc containing it
+func dateOrderRule(start, end AttributeID) Rule {
+	startBloc := BlocID(start[:len("S21.G00.00")])
+	return Rule{
+		Name: "end date on or after start date",
+		Bloc: BlocID(end[:len("S21.G00.00")]),
+		Check: func(occ *Occurrence, report reportFunc) {
+			startOcc := occ
+			if occ.ID != startBloc {
+				startOcc = occ.Ancestor(startBloc)
+			}
+			if startOcc == nil {
+				return
+			}
+			startDate, ok := startOcc.Date(start)
+			if !ok {
+				return
+			}
+			if endDate, ok := occ.Date(end); ok && endDate.Before(startDate) {
+				report(end, "%s is before %s %s", weirdDateFormat(&endDate), start, weirdDateFormat(&startDate))
+			}
+		},
+	}
+}
+
+// checkPayPeriodInMonth checks that the pay period of a Remuneration falls
+// within the main declared month
+func checkPayPeriodInMonth(occ *Occurrence, report reportFunc) {
+	declaration := occ.Ancestor("S20.G00.05")
+	if declaration == nil {
+		return
+	}
+	month, ok := declaration.Date("S20.G00.05.005")
+	if !ok {
+		return
+	}
+
+	for _, id := range []AttributeID{"S21.G00.51.001", "S21.G00.51.002"} {
+		date, ok := occ.Date(id)
+		if ok && (date.Before(firstDayOfMonth(month)) || date.After(lastDayOfMonth(month))) {
+			report(id, "%s is outside of the declared month %s", weirdDateFormat(&date), month.Format("2006-01"))
+		}
+	}
+}
+
+// checkRemunerationContract checks that the contract of a Remuneration is one
+// of the contracts of the individual
+func checkRemunerationContract(occ *Occurrence, report reportFunc) {
+	number, ok := occ.Rubrics["S21.G00.51.010"]
+	individual := occ.Ancestor("S21.G00.30")
+	if !ok || individual == nil {
+		return
+	}
+
+	var numbers []string
+	for _, contract := range individual.Descendants("S21.G00.40") {
+		if n, ok := contract.Rubrics["S21.G00.40.009"]; ok {
+			numbers = append(numbers, n.Value)
+		}
+	}
+	if !slices.Contains(numbers, number.Value) {
+		report("S21.G00.51.010", "no contract %q (S21.G00.40.009) for the individual of line %d", number.Value, individual.Line)
+	}
+}
+
+// checkNIRGender checks that the first digit of the NIR of an individual
+// matches their gender. Temporary NIRs, starting with another digit, are not
+// checked.
+func checkNIRGender(occ *Occurrence, report reportFunc) {
+	nir, ok := occ.Rubrics["S21.G00.30.001"]
+	gender, ok2 := occ.Rubrics["S21.G00.30.005"]
+	if !ok || !ok2 {
+		return
+	}
+
+	digit := nir.Value[:1]
+	if (digit == "1" || digit == "2") && "0"+digit != gender.Value {
+		report("S21.G00.30.005", "%s does not match the first digit %s of the NIR", gender.Value, digit)
+	}
+}
+
+// checkRules runs the Rules on occ and the occurrences nested in it
+func (v *validator) checkRules(occ *Occurrence) {
+	for _, rule := range Rules {
+		if rule.Bloc != occ.ID {
+			continue
+		}
+		rule.Check(occ, func(id AttributeID, format string, args ...interface{}) {
+			line := occ.Line
+			if rubric, ok := occ.Rubrics[id]; ok {
+				line = rubric.Line
+			}
+			v.report(line, string(id), GetAttribute(id).Label, "%s: %s", rule.Name, fmt.Sprintf(format, args...))
+		})
+	}
+	for _, child := range occ.Blocs {
+		v.checkRules(child)
+	}
+}
+
+// Date returns the date of the rubric id of the occurrence, ok is false when
+// the rubric is missing
+func (o *Occurrence) Date(id AttributeID) (date time.Time, ok bool) {
+	rubric, ok := o.Rubrics[id]
+	if !ok {
+		return time.Time{}, false
+	}
+	date, err := time.Parse("20060102", rubric.Value)
+	return date, err == nil
+}
+
+// Ancestor returns the closest occurrence of the bloc id containing the
+// occurrence, or nil
+func (o *Occurrence) Ancestor(id BlocID) *Occurrence {
+	for p := o.Parent; p != nil; p = p.Parent {
+		if p.ID == id {
+			return p
+		}
+	}
+	return nil
+}
+
+// Descendants returns the occurrences of the bloc id nested in the occurrence,
+// at any depth
+func (o *Occurrence) Descendants(id BlocID) []*Occurrence {
+	var descendants []*Occurrence
+	for _, child := range o.Blocs {
+		if child.ID == id {
+			descendants = append(descendants, child)
+		}
+		descendants = append(descendants, child.Descendants(id)...)
+	}
+	return descendants
+}
@@ -0,0 +1,110 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestGeneratedDSNHasNoAnomaly(t *testing.T) {
+	// The data is random, a few files cover most of the generated cases
+	for _, yearEnd := range []bool{false, true} {
+		for range 10 {
+			var buf bytes.Buffer
+			if err := generateDSN(&buf, yearEnd); err != nil {
+				t.Fatalf("generateDSN(yearEnd=%t) error = %v", yearEnd, err)
+			}
+
+			anomalies, err := ValidateDSN(&buf, false)
+			if err != nil {
+				t.Fatalf("ValidateDSN() error = %v", err)
+			}
+			for _, anomaly := range anomalies {
+				t.Errorf("generateDSN(yearEnd=%t): %v", yearEnd, anomaly)
+			}
+		}
+	}
+}
+
+// ruleDSN is an envoi declaring March 2025 with an individual, their contract
+// with a work stoppage, and its remuneration, breaking none of the Rules
+var ruleDSN = []string{
+	"S10.G00.00,''",
+	"S10.G00.01,''",
+	"S10.G00.02,''",
+	"S20.G00.05,''",
+	"S20.G00.05.005,'20250301'",
+	"S21.G00.06,''",
+	"S21.G00.11,''",
+	"S21.G00.30,''",
+	"S21.G00.30.001,'1850575123456'",
+	"S21.G00.30.005,'01'",
+	"S21.G00.40,''",
+	"S21.G00.40.001,'20240115'",
+	"S21.G00.40.009,'00001'",
+	"S21.G00.40.010,'20260115'",
+	"S21.G00.60,''",
+	"S21.G00.60.002,'20250310'",
+	"S21.G00.50,''",
+	"S21.G00.51,''",
+	"S21.G00.51.001,'20250301'",
+	"S21.G00.51.002,'20250331'",
+	"S21.G00.51.010,'00001'",
+}
+
+// withRubric returns ruleDSN with the value of the rubric id replaced, followed
+// by its totals
+func withRubric(id AttributeID, value string) string {
+	var lines []string
+	rubrics := 2
+	for _, line := range ruleDSN {
+		if strings.HasPrefix(line, string(id)+",") {
+			line = fmt.Sprintf("%s,'%s'", id, value)
+		}
+		if strings.Count(line[:strings.Index(line, ",")], ".") == 3 {
+			rubrics++
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, "S90.G00.90,''", fmt.Sprintf("S90.G00.90.001,'%d'", rubrics), "S90.G00.90.002,'1'")
+	return strings.Join(lines, "\n")
+}
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		id    AttributeID
+		value string
+		rule  string // Name of the rule reporting the anomaly, empty when none
+	}{
+		{"valid envoi", "S21.G00.30.005", Male, ""},
+		{"end date before start date", "S21.G00.40.010", "20231231", "end date on or after start date"},
+		{"stoppage before the contract", "S21.G00.60.002", "20240110", "end date on or after start date"},
+		{"pay period outside of the declared month", "S21.G00.51.002", "20250401", "pay period within the declared month"},
+		{"remuneration without contract", "S21.G00.51.010", "00002", "contract of the remuneration declared"},
+		{"gender not matching the NIR", "S21.G00.30.005", Female, "gender matching the NIR"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anomalies, err := ValidateDSN(strings.NewReader(withRubric(tt.id, tt.value)), false)
+			if err != nil {
+				t.Fatalf("ValidateDSN() error = %v", err)
+			}
+
+			if tt.rule == "" {
+				if len(anomalies) > 0 {
+					t.Fatalf("ValidateDSN() = %v, want no anomaly", anomalies)
+				}
+				return
+			}
+			if len(anomalies) != 1 {
+				t.Fatalf("ValidateDSN() = %v, want 1 anomaly", anomalies)
+			}
+			if anomalies[0].ID != string(tt.id) || !strings.HasPrefix(anomalies[0].Message, tt.rule+":") {
+				t.Errorf("anomaly = %v, want %s: %s", anomalies[0], tt.id, tt.rule)
+			}
+		})
+	}
+}
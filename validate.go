@@ -0,0 +1,359 @@
+package main
+
+// This file checks the structure of a DSN file, generated or real: the
+// rubrics, the order and the nesting of the blocs, their number of
+// occurrences, and the totals of the envoi. The controls between rubrics are
+// in rules.go.
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Nesting represents the place of a bloc in the DSN: its parent bloc, empty
+// for the blocs at the root of the envoi, and its minimum and maximum number
+// of occurrences under each occurrence of the parent. Max is -1 when the
+// number of occurrences is not limited.
+type Nesting struct {
+	Parent BlocID
+	Min    int
+	Max    int
+}
+
+// Structure maps the blocs to their Nesting
+var Structure = map[BlocID]Nesting{
+	"S10.G00.00": {"", 1, 1},
+	"S10.G00.01": {"S10.G00.00", 1, 1},
+	"S10.G00.02": {"S10.G00.01", 1, -1},
+	"S20.G00.05": {"", 1, 1},
+	"S20.G00.07": {"S20.G00.05", 0, -1},
+	"S20.G00.08": {"S20.G00.05", 0, -1},
+	"S21.G00.06": {"S20.G00.05", 1, 1},
+	"S21.G00.11": {"S21.G00.06", 1, 1},
+	"S21.G00.12": {"S21.G00.11", 0, -1},
+	"S21.G00.13": {"S21.G00.11", 0, 1},
+	"S21.G00.15": {"S21.G00.11", 0, -1},
+	"S21.G00.16": {"S21.G00.15", 0, -1},
+	"S21.G00.20": {"S21.G00.11", 0, -1},
+	"S21.G00.22": {"S21.G00.11", 0, -1},
+	"S21.G00.23": {"S21.G00.22", 0, -1},
+	"S21.G00.30": {"S21.G00.11", 0, -1},
+	"S21.G00.31": {"S21.G00.30", 0, 1},
+	"S21.G00.34": {"S21.G00.40", 0, -1},
+	"S21.G00.40": {"S21.G00.30", 1, -1},
+	"S21.G00.41": {"S21.G00.40", 0, -1},
+	"S21.G00.44": {"S21.G00.30", 0, -1},
+	"S21.G00.45": {"S21.G00.30", 0, 1},
+	"S21.G00.50": {"S21.G00.30", 0, -1},
+	"S21.G00.51": {"S21.G00.50", 0, -1},
+	"S21.G00.52": {"S21.G00.50", 0, -1},
+	"S21.G00.53": {"S21.G00.50", 0, -1},
+	"S21.G00.54": {"S21.G00.50", 0, -1},
+	"S21.G00.55": {"S21.G00.50", 0, -1},
+	"S21.G00.56": {"S21.G00.50", 0, -1},
+	"S21.G00.58": {"S21.G00.50", 0, -1},
+	"S21.G00.60": {"S21.G00.40", 0, -1},
+	"S21.G00.62": {"S21.G00.40", 0, -1},
+	"S21.G00.63": {"S21.G00.62", 0, 1},
+	"S21.G00.65": {"S21.G00.40", 0, -1},
+	"S21.G00.66": {"S21.G00.40", 0, -1},
+	"S21.G00.70": {"S21.G00.30", 0, -1},
+	"S21.G00.71": {"S21.G00.30", 0, -1},
+	"S21.G00.72": {"S21.G00.30", 0, -1},
+	"S21.G00.73": {"S21.G00.70", 0, -1},
+	"S21.G00.78": {"S21.G00.30", 0, -1},
+	"S21.G00.79": {"S21.G00.78", 0, -1},
+	"S21.G00.81": {"S21.G00.78", 0, -1},
+	"S21.G00.82": {"S21.G00.11", 0, -1},
+	"S21.G00.83": {"S21.G00.72", 1, -1},
+	"S21.G00.84": {"S21.G00.83", 0, -1},
+	"S21.G00.85": {"S21.G00.40", 0, -1},
+	"S21.G00.86": {"S21.G00.30", 0, -1},
+	"S21.G00.95": {"S21.G00.30", 0, -1},
+	"S21.G00.98": {"S21.G00.30", 0, -1},
+	"S89.G00.32": {"S21.G00.11", 0, -1},
+	"S89.G00.33": {"S89.G00.32", 0, -1},
+	"S89.G00.35": {"S89.G00.32", 0, -1},
+	"S89.G00.43": {"S89.G00.32", 1, -1},
+	"S89.G00.67": {"S21.G00.11", 0, -1},
+	"S89.G00.87": {"S21.G00.11", 0, -1},
+	"S89.G00.88": {"S21.G00.11", 0, -1},
+	"S89.G00.89": {"S21.G00.11", 0, -1},
+	"S89.G00.91": {"S21.G00.11", 0, -1},
+	"S89.G00.92": {"S89.G00.91", 0, -1},
+	"S89.G00.93": {"S89.G00.91", 0, -1},
+	"S89.G00.94": {"S89.G00.91", 0, -1},
+	"S90.G00.90": {"", 1, 1},
+}
+
+// nestedBlocs maps the blocs to the blocs nested in them, "" being the root
+var nestedBlocs = map[BlocID][]BlocID{}
+
+func init() {
+	for id, nesting := range Structure {
+		nestedBlocs[nesting.Parent] = append(nestedBlocs[nesting.Parent], id)
+	}
+}
+
+// Anomaly represents a problem found at a line of a DSN file, on the rubric
+// or the bloc ID
+type Anomaly struct {
+	Line    int
+	ID      string
+	Label   string
+	Message string
+}
+
+func (a Anomaly) String() string {
+	if a.Label == "" {
+		return fmt.Sprintf("line %d: %s: %s", a.Line, a.ID, a.Message)
+	}
+	return fmt.Sprintf("line %d: %s (%s): %s", a.Line, a.ID, a.Label, a.Message)
+}
+
+// linePattern matches a line of a DSN file: a rubric with its value, or the
+// header of a bloc with an empty value
+var linePattern = regexp.MustCompile(`^(S[0-9]{2}\.G00\.[0-9]{2})(\.[0-9]{3})?,'(.*)'$`)
+
+// Occurrence represents an occurrence of a bloc in a DSN file, starting at
+// Line, with its valid rubrics and the occurrences of the blocs nested in it
+type Occurrence struct {
+	ID      BlocID
+	Line    int
+	Parent  *Occurrence
+	Blocs   []*Occurrence
+	Rubrics map[AttributeID]Rubric
+
+	lastRubric AttributeID    // Last rubric read in the occurrence
+	lastChild  BlocID         // Last bloc read directly under the occurrence
+	counts     map[BlocID]int // Number of occurrences of the blocs directly under the occurrence
+}
+
+// Rubric represents the value of a rubric at a line of a DSN file
+type Rubric struct {
+	Line  int
+	Value string
+}
+
+func newOccurrence(id BlocID, n int) *Occurrence {
+	return &Occurrence{ID: id, Line: n, Rubrics: map[AttributeID]Rubric{}, counts: map[BlocID]int{}}
+}
+
+// validator holds the state of the validation of a DSN file
+type validator struct {
+	anomalies []Anomaly
+	// Open occurrences, from the root of the envoi to the current bloc
+	stack   []*Occurrence
+	current *Occurrence
+
+	rubrics      int                    // Number of rubrics of the file
+	declarations int                    // Number of S20.G00.05 blocs of the file
+	totals       map[AttributeID]Rubric // Rubrics of S90.G00.90
+}
+
+// ValidateDSN checks the DSN file read from r, decoding it from ISO-8859-1 if
+// latin1 is set, and returns its anomalies
+func ValidateDSN(r io.Reader, latin1 bool) ([]Anomaly, error) {
+	root := newOccurrence("", 0)
+	v := &validator{
+		stack:  []*Occurrence{root},
+		totals: map[AttributeID]Rubric{},
+	}
+
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := scanner.Text()
+		if latin1 {
+			line = decodeLatin1(scanner.Bytes())
+		}
+		v.readLine(n, strings.TrimSuffix(line, "\r"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// The root is checked at the end of the file
+	root.Line = n
+	v.closeAbove(-1)
+	v.checkTotals(n)
+	v.checkRules(root)
+
+	slices.SortStableFunc(v.anomalies, func(a, b Anomaly) int { return a.Line - b.Line })
+	return v.anomalies, nil
+}
+
+// report adds an anomaly at line n on the rubric or bloc id
+func (v *validator) report(n int, id string, label string, format string, args ...interface{}) {
+	v.anomalies = append(v.anomalies, Anomaly{Line: n, ID: id, Label: label, Message: fmt.Sprintf(format, args...)})
+}
+
+// readLine checks a line of the file, opening a new occurrence of a bloc at
+// its header, or at its first rubric in files without headers
+func (v *validator) readLine(n int, line string) {
+	match := linePattern.FindStringSubmatch(line)
+	if match == nil {
+		v.report(n, line, "", "malformed line, expected CODE,'value'")
+		return
+	}
+	blocID, value := BlocID(match[1]), match[3]
+
+	if match[2] == "" {
+		if value != "" {
+			v.report(n, string(blocID), "", "bloc header with a value")
+		}
+		v.open(n, blocID)
+		return
+	}
+
+	id := AttributeID(match[1] + match[2])
+	v.rubrics++
+	if v.current == nil || v.current.ID != blocID || id <= v.current.lastRubric {
+		v.open(n, blocID)
+	}
+	v.current.lastRubric = id
+
+	if strings.Contains(strings.ReplaceAll(value, "''", ""), "'") {
+		v.report(n, string(id), "", "unescaped apostrophe in %q", value)
+		return
+	}
+	value = unescape(value)
+
+	if _, ok := Attributes[id]; !ok {
+		v.report(n, string(id), "", "unknown rubric")
+		return
+	}
+	attribute := GetAttribute(id)
+	if err := attribute.Schema.Validate(value); err != nil {
+		v.report(n, string(id), attribute.Label, "%v", err)
+		return
+	}
+	v.current.Rubrics[id] = Rubric{Line: n, Value: value}
+
+	if blocID == "S90.G00.90" {
+		v.totals[id] = Rubric{Line: n, Value: value}
+	}
+}
+
+// open starts a new occurrence of the bloc id at line n, closing the
+// occurrences which can not contain it
+func (v *validator) open(n int, id BlocID) {
+	occ := newOccurrence(id, n)
+	v.current = occ
+
+	if _, ok := Blocs[id]; !ok {
+		v.report(n, string(id), "", "unknown bloc")
+		return
+	}
+	bloc := GetBloc(id)
+	nesting, ok := Structure[id]
+	if !ok {
+		v.report(n, string(id), bloc.Label, "bloc missing from the structure of the DSN")
+		return
+	}
+	if id == "S20.G00.05" {
+		v.declarations++
+	}
+
+	parent := -1
+	for i := len(v.stack) - 1; i >= 0; i-- {
+		if v.stack[i].ID == nesting.Parent {
+			parent = i
+			break
+		}
+	}
+	if parent < 0 {
+		v.report(n, string(id), bloc.Label, "bloc outside of its parent %s (%s)", nesting.Parent, GetBloc(nesting.Parent).Label)
+		v.stack = append(v.stack, occ)
+		return
+	}
+	v.closeAbove(parent)
+
+	p := v.stack[parent]
+	occ.Parent = p
+	p.Blocs = append(p.Blocs, occ)
+	if id < p.lastChild {
+		v.report(n, string(id), bloc.Label, "bloc after %s (%s)", p.lastChild, GetBloc(p.lastChild).Label)
+	}
+	p.lastChild = id
+	p.counts[id]++
+	if nesting.Max >= 0 && p.counts[id] > nesting.Max {
+		v.report(n, string(id), bloc.Label, "more than %d occurrences under %s", nesting.Max, v.describe(p))
+	}
+	v.stack = append(v.stack, occ)
+}
+
+// closeAbove closes the occurrences of the stack above index i, checking the
+// minimum number of occurrences of the blocs nested in them
+func (v *validator) closeAbove(i int) {
+	for len(v.stack)-1 > i {
+		occ := v.stack[len(v.stack)-1]
+		v.stack = v.stack[:len(v.stack)-1]
+		for _, child := range nestedBlocs[occ.ID] {
+			if nesting := Structure[child]; occ.counts[child] < nesting.Min {
+				v.report(occ.Line, string(child), GetBloc(child).Label, "%d occurrences under %s, expected at least %d", occ.counts[child], v.describe(occ), nesting.Min)
+			}
+		}
+	}
+}
+
+// describe names an occurrence in the anomalies
+func (v *validator) describe(occ *Occurrence) string {
+	if occ.ID == "" {
+		return "the envoi"
+	}
+	return fmt.Sprintf("%s (%s) of line %d", occ.ID, GetBloc(occ.ID).Label, occ.Line)
+}
+
+// checkTotals checks the totals declared in S90.G00.90 against the file of n
+// lines
+func (v *validator) checkTotals(n int) {
+	expected := map[AttributeID]int{
+		"S90.G00.90.001": v.rubrics,
+		"S90.G00.90.002": v.declarations,
+	}
+	// A missing S90.G00.90 bloc is already reported by the structure
+	if len(v.totals) == 0 {
+		return
+	}
+	for _, id := range []AttributeID{"S90.G00.90.001", "S90.G00.90.002"} {
+		total, ok := v.totals[id]
+		if !ok {
+			v.report(n, string(id), GetAttribute(id).Label, "missing total")
+			continue
+		}
+		if value, err := strconv.Atoi(total.Value); err == nil && value != expected[id] {
+			v.report(total.Line, string(id), GetAttribute(id).Label, "declared %d, counted %d", value, expected[id])
+		}
+	}
+}
+
+// validateFile prints the anomalies of the DSN file at path, and exits with an
+// error status when there are any
+func validateFile(path string, latin1 bool) {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close()
+
+	anomalies, err := ValidateDSN(file, latin1)
+	if err != nil {
+		log.Fatalf("cannot read DSN file: %v", err)
+	}
+	for _, anomaly := range anomalies {
+		fmt.Println(anomaly)
+	}
+	if len(anomalies) > 0 {
+		log.Fatalf("%d anomalies in %s", len(anomalies), path)
+	}
+	log.Printf("No anomaly in %s", path)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+// minimalDSN is the smallest valid envoi: the mandatory blocs, without
+// rubrics, and the totals
+var minimalDSN = []string{
+	"S10.G00.00,''",
+	"S10.G00.01,''",
+	"S10.G00.02,''",
+	"S20.G00.05,''",
+	"S21.G00.06,''",
+	"S21.G00.11,''",
+	"S90.G00.90,''",
+	"S90.G00.90.001,'2'",
+	"S90.G00.90.002,'1'",
+}
+
+// withLines returns minimalDSN with lines inserted before the line at index i
+func withLines(i int, lines ...string) string {
+	return strings.Join(slices.Insert(slices.Clone(minimalDSN), i, lines...), "\n")
+}
+
+// withoutLine returns minimalDSN without the line at index i
+func withoutLine(i int) string {
+	return strings.Join(slices.Delete(slices.Clone(minimalDSN), i, i+1), "\n")
+}
+
+func TestValidateDSN(t *testing.T) {
+	type expected struct {
+		id      string
+		message string
+	}
+
+	tests := []struct {
+		name      string
+		dsn       string
+		anomalies []expected
+	}{
+		{
+			name: "minimal envoi",
+			dsn:  strings.Join(minimalDSN, "\n"),
+		},
+		{
+			name: "empty file",
+			dsn:  "",
+			anomalies: []expected{
+				{"S10.G00.00", "expected at least 1"},
+				{"S20.G00.05", "expected at least 1"},
+				{"S90.G00.90", "expected at least 1"},
+			},
+		},
+		{
+			name: "bloc outside of its parent",
+			dsn:  withLines(6, "S21.G00.40,''"),
+			anomalies: []expected{
+				{"S21.G00.40", "bloc outside of its parent S21.G00.30"},
+			},
+		},
+		{
+			name: "unknown bloc",
+			dsn:  withLines(6, "S42.G00.01,''"),
+			anomalies: []expected{
+				{"S42.G00.01", "unknown bloc"},
+			},
+		},
+		{
+			name: "missing mandatory bloc",
+			dsn:  withoutLine(2),
+			anomalies: []expected{
+				{"S10.G00.02", "0 occurrences under S10.G00.01"},
+			},
+		},
+		{
+			name: "too many occurrences",
+			dsn:  withLines(6, "S21.G00.11,''"),
+			anomalies: []expected{
+				{"S21.G00.11", "more than 1 occurrences under S21.G00.06"},
+			},
+		},
+		{
+			name: "bloc out of order",
+			dsn:  withLines(6, "S21.G00.13,''", "S21.G00.12,''"),
+			anomalies: []expected{
+				{"S21.G00.12", "bloc after S21.G00.13"},
+			},
+		},
+		{
+			name: "second declaration",
+			dsn:  withLines(6, "S20.G00.05,''", "S21.G00.06,''", "S21.G00.11,''"),
+			anomalies: []expected{
+				{"S20.G00.05", "more than 1 occurrences under the envoi"},
+				{"S90.G00.90.002", "declared 1, counted 2"},
+			},
+		},
+		{
+			name: "wrong totals",
+			dsn: strings.Join(append(slices.Clone(minimalDSN[:7]),
+				"S90.G00.90.001,'5'",
+				"S90.G00.90.002,'2'",
+			), "\n"),
+			anomalies: []expected{
+				{"S90.G00.90.001", "declared 5, counted 2"},
+				{"S90.G00.90.002", "declared 2, counted 1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anomalies, err := ValidateDSN(strings.NewReader(tt.dsn), false)
+			if err != nil {
+				t.Fatalf("ValidateDSN() error = %v", err)
+			}
+			// Anomalies found at the same line come in no particular order
+			slices.SortStableFunc(anomalies, func(a, b Anomaly) int { return strings.Compare(a.ID, b.ID) })
+
+			if len(anomalies) != len(tt.anomalies) {
+				t.Fatalf("ValidateDSN() = %v, want %d anomalies", anomalies, len(tt.anomalies))
+			}
+			for i, want := range tt.anomalies {
+				if anomalies[i].ID != want.id || !strings.Contains(anomalies[i].Message, want.message) {
+					t.Errorf("anomaly %d = %v, want %s: %s", i, anomalies[i], want.id, want.message)
+				}
+			}
+		})
+	}
+}
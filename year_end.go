@@ -0,0 +1,308 @@
+package main
+
+// This file generates the S89 blocs of the year-end declaration, where the
+// company declares the fees and benefits paid during the year to people who
+// are not its employees, the free shares, stock options and BSPCE warrants
+// acquired by its employees, and the defined-benefit pension rights of its
+// executives.
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+// FeeBeneficiary represents a person or a company paid fees during the year
+// French: Bénéficiaire des honoraires
+type FeeBeneficiary struct {
+	Profession          string            `dsn:"S89.G00.32.001"`                 // Profession ou qualité
+	LastName            string            `dsn:"S89.G00.32.002,upper,omitempty"` // Nom du bénéficiaire des honoraires
+	FirstName           string            `dsn:"S89.G00.32.003,upper,omitempty"` // Prénom du bénéficiaire des honoraires
+	SIREN               string            `dsn:"S89.G00.32.004,omitempty"`       // Siren du bénéficiaire des honoraires
+	NIC                 string            `dsn:"S89.G00.32.005,omitempty"`       // Nic du bénéficiaire des honoraires
+	CompanyName         string            `dsn:"S89.G00.32.006,omitempty"`       // Raison sociale du bénéficiaire des honoraires
+	BuildingComplement  string            `dsn:"S89.G00.32.007"`                 // Complément de localisation de la construction
+	StreetAddress       string            `dsn:"S89.G00.32.008"`                 // Numéro, extension, nature et libellé de la voie
+	INSEECityCode       string            `dsn:"S89.G00.32.009"`                 // Code INSEE de la commune
+	DeliveryService     string            `dsn:"S89.G00.32.010"`                 // Service de distribution, complément de localisation de la voie
+	PostalCode          string            `dsn:"S89.G00.32.011"`                 // Code postal
+	City                string            `dsn:"S89.G00.32.012"`                 // Localité
+	CountryCode         string            `dsn:"S89.G00.32.013,upper"`           // Code pays
+	ForeignDistribution string            `dsn:"S89.G00.32.014"`                 // Code de distribution à l'étranger
+	WithholdingTaxCode  string            `dsn:"S89.G00.32.015,omitempty"`       // Code taux réduit ou dispense de retenue à la source
+	CopyrightVATAmount  float64           `dsn:"S89.G00.32.016,amount"`          // Montant TVA droits d'auteurs
+	Vintage             string            `dsn:"S89.G00.32.017"`                 // Millésime de rattachement
+	Benefits            []BenefitInKind   `dsn:"S89.G00.33"`                     // Avantage en nature
+	Expenses            []ExpenseCoverage `dsn:"S89.G00.35"`                     // Prise en charge des frais professionnels
+	Remunerations       []FeeRemuneration `dsn:"S89.G00.43"`                     // Rémunération
+}
+
+// BenefitInKind represents a benefit in kind granted to a FeeBeneficiary
+// French: Avantages en nature
+type BenefitInKind struct {
+	Type   string  `dsn:"S89.G00.33.001"`        // Code type avantage en nature
+	Amount float64 `dsn:"S89.G00.33.002,amount"` // Montant avantage en nature
+}
+
+const (
+	BenefitFood    = "01" // Nourriture
+	BenefitHousing = "02" // Logement
+	BenefitCar     = "03" // Voiture
+	BenefitOther   = "04" // Autres avantages
+	BenefitTelecom = "05" // Outils issus des nouvelles technologies de l'information et de la communication
+)
+
+// ExpenseCoverage represents the expenses of a FeeBeneficiary paid by the company
+// French: Prise en charge des indemnités
+type ExpenseCoverage struct {
+	Type   string  `dsn:"S89.G00.35.001"`        // Code modalité de prise en charge des indemnités
+	Amount float64 `dsn:"S89.G00.35.002,amount"` // Montant de l'indemnité
+}
+
+const (
+	ExpenseFlatAllowance = "01" // Allocation forfaitaire
+	ExpenseReimbursement = "02" // Remboursement
+	ExpenseDirectPayment = "03" // Prise en charge directe par l'employeur
+)
+
+// FeeRemuneration represents the amount of one type of fee paid to a FeeBeneficiary
+// French: Rémunérations
+type FeeRemuneration struct {
+	Type   string  `dsn:"S89.G00.43.001"`        // Code type de la rémunération
+	Amount float64 `dsn:"S89.G00.43.002,amount"` // Montant de la rémunération
+}
+
+const (
+	FeeHonoraria         = "01" // Honoraires
+	FeeCommissions       = "02" // Commissions
+	FeeBrokerage         = "03" // Courtages
+	FeeRebates           = "04" // Ristournes
+	FeeAttendance        = "05" // Jetons de présence
+	FeeCopyright         = "06" // Droits d'auteur
+	FeeInventorRights    = "07" // Droits d'inventeur
+	FeeOtherRemuneration = "08" // Autres rémunérations
+)
+
+// GenerateFeeBeneficiary creates a new FeeBeneficiary paid fees during the
+// year of the declared month. The beneficiary is either a company identified
+// by its SIRET, or a self-employed person identified by their name.
+func GenerateFeeBeneficiary(month time.Time) FeeBeneficiary {
+	beneficiary := FeeBeneficiary{
+		Profession:          strings.ToUpper(gofakeit.JobTitle()),
+		BuildingComplement:  gofakeit.Name(),
+		StreetAddress:       gofakeit.Street(),
+		INSEECityCode:       gofakeit.DigitN(5),
+		DeliveryService:     gofakeit.Word(),
+		PostalCode:          gofakeit.Zip(),
+		City:                gofakeit.City(),
+		CountryCode:         "FR",
+		ForeignDistribution: gofakeit.Word(),
+		Vintage:             strconv.Itoa(month.Year()),
+	}
+
+	if chance(0.5) {
+		beneficiary.SIREN = gofakeit.DigitN(9)
+		beneficiary.NIC = gofakeit.DigitN(5)
+		beneficiary.CompanyName = gofakeit.Company()
+	} else {
+		beneficiary.LastName = gofakeit.LastName()
+		beneficiary.FirstName = gofakeit.FirstName()
+	}
+
+	fees := GetNomenclature("S89.G00.43.001").Values()
+	gofakeit.ShuffleStrings(fees)
+	for _, fee := range fees[:gofakeit.IntRange(1, 3)] {
+		remuneration := FeeRemuneration{
+			Type:   fee,
+			Amount: roundAmount(gofakeit.Float64Range(500, 50000)),
+		}
+		// Authors pay a reduced VAT rate on their copyright
+		if fee == FeeCopyright {
+			beneficiary.CopyrightVATAmount = roundAmount(remuneration.Amount * 0.1)
+		}
+		beneficiary.Remunerations = append(beneficiary.Remunerations, remuneration)
+	}
+
+	if chance(0.2) {
+		beneficiary.Benefits = append(beneficiary.Benefits, BenefitInKind{
+			Type:   drawCode("S89.G00.33.001"),
+			Amount: roundAmount(gofakeit.Float64Range(100, 5000)),
+		})
+	}
+
+	if chance(0.4) {
+		beneficiary.Expenses = append(beneficiary.Expenses, ExpenseCoverage{
+			Type:   drawCode("S89.G00.35.001"),
+			Amount: roundAmount(gofakeit.Float64Range(50, 3000)),
+		})
+	}
+
+	return beneficiary
+}
+
+// FreeShareGrant represents free shares definitively acquired by an employee
+// French: Actions gratuites
+type FreeShareGrant struct {
+	Context              string    `dsn:"S89.G00.87.001"`           // Code contexte
+	Shares               int       `dsn:"S89.G00.87.002"`           // Nombre d'actions
+	UnitValue            float64   `dsn:"S89.G00.87.003,amount"`    // Valeur unitaire de l'action
+	FrenchSourceFraction float64   `dsn:"S89.G00.87.004,rate"`      // Fraction du gain d'acquisition de source française
+	GrantDate            time.Time `dsn:"S89.G00.87.005"`           // Date d'attribution
+	VestingDate          time.Time `dsn:"S89.G00.87.006"`           // Date d'acquisition définitive
+	NIR                  string    `dsn:"S89.G00.87.007"`           // Numéro d'inscription au répertoire
+	TemporaryTechnicalID string    `dsn:"S89.G00.87.008,omitempty"` // Numéro technique temporaire
+}
+
+// StockOptionGrant represents stock options exercised by an employee
+// French: Options sur titres (stock options)
+type StockOptionGrant struct {
+	Context              string    `dsn:"S89.G00.88.001"`           // Code contexte
+	Options              int       `dsn:"S89.G00.88.002"`           // Nombre d'options
+	UnitValue            float64   `dsn:"S89.G00.88.003,amount"`    // Valeur unitaire de l'action
+	SubscriptionPrice    float64   `dsn:"S89.G00.88.004,amount"`    // Prix de souscription de l'action
+	FrenchSourceFraction float64   `dsn:"S89.G00.88.005,rate"`      // Fraction du gain de levée d'option de source française
+	GrantDate            time.Time `dsn:"S89.G00.88.006"`           // Date d'attribution
+	ExerciseDate         time.Time `dsn:"S89.G00.88.007"`           // Date de levée de l'option
+	NIR                  string    `dsn:"S89.G00.88.008"`           // Numéro d'inscription au répertoire
+	TemporaryTechnicalID string    `dsn:"S89.G00.88.009,omitempty"` // Numéro technique temporaire
+}
+
+// WarrantGrant represents shares acquired by an employee exercising
+// founder warrants
+// French: Bons de souscription de parts de créateur d'entreprise (BSPCE)
+type WarrantGrant struct {
+	Shares               int       `dsn:"S89.G00.89.001"`           // Nombre de titres
+	AcquisitionPrice     float64   `dsn:"S89.G00.89.002,amount"`    // Prix d'acquisition des titres
+	UnitValue            float64   `dsn:"S89.G00.89.003,amount"`    // Valeur unitaire des titres au jour de l'exercice des bons
+	FrenchSourceFraction float64   `dsn:"S89.G00.89.004,rate"`      // Fraction du gain de source française
+	AcquisitionDate      time.Time `dsn:"S89.G00.89.005"`           // Date d'acquisition des titres
+	YearsInCompany       int       `dsn:"S89.G00.89.006"`           // Durée d'exercice de l'activité du bénéficiaire dans l'entreprise
+	NIR                  string    `dsn:"S89.G00.89.007"`           // Numéro d'inscription au répertoire
+	TemporaryTechnicalID string    `dsn:"S89.G00.89.008,omitempty"` // Numéro technique temporaire
+}
+
+const (
+	EquityContextAcquisition = "01" // Acquisition définitive ou levée
+	EquityContextSale        = "02" // Cession
+)
+
+// freeShareVestingPeriod is the minimum legal period between the grant and
+// the definitive acquisition of free shares
+const freeShareVestingPeriod = 1
+
+// GenerateFreeShareGrant creates a new FreeShareGrant of an individual, vested
+// during the year of the declared month. ok is false when the contract is too
+// recent for shares granted after its start to be vested.
+func GenerateFreeShareGrant(individual Individual, contract Contrat, month time.Time) (grant FreeShareGrant, ok bool) {
+	vestingDate, grantDate, ok := generateVestingChronology(contract, month, freeShareVestingPeriod)
+	if !ok {
+		return FreeShareGrant{}, false
+	}
+
+	return FreeShareGrant{
+		Context:              EquityContextAcquisition,
+		Shares:               gofakeit.IntRange(10, 2000),
+		UnitValue:            roundAmount(gofakeit.Float64Range(1, 200)),
+		FrenchSourceFraction: 100,
+		GrantDate:            grantDate,
+		VestingDate:          vestingDate,
+		NIR:                  individual.NIR,
+	}, true
+}
+
+// GenerateStockOptionGrant creates a new StockOptionGrant of an individual,
+// exercised during the year of the declared month at a value above the
+// subscription price. ok is false when the contract is too recent.
+func GenerateStockOptionGrant(individual Individual, contract Contrat, month time.Time) (grant StockOptionGrant, ok bool) {
+	exerciseDate, grantDate, ok := generateVestingChronology(contract, month, 1)
+	if !ok {
+		return StockOptionGrant{}, false
+	}
+
+	price := roundAmount(gofakeit.Float64Range(1, 100))
+	return StockOptionGrant{
+		Context:              EquityContextAcquisition,
+		Options:              gofakeit.IntRange(100, 10000),
+		UnitValue:            roundAmount(price * gofakeit.Float64Range(1.1, 5)),
+		SubscriptionPrice:    price,
+		FrenchSourceFraction: 100,
+		GrantDate:            grantDate,
+		ExerciseDate:         exerciseDate,
+		NIR:                  individual.NIR,
+	}, true
+}
+
+// GenerateWarrantGrant creates a new WarrantGrant of an individual, exercised
+// during the year of the declared month. ok is false when the contract is
+// too recent.
+func GenerateWarrantGrant(individual Individual, contract Contrat, month time.Time) (grant WarrantGrant, ok bool) {
+	acquisitionDate, _, ok := generateVestingChronology(contract, month, 1)
+	if !ok {
+		return WarrantGrant{}, false
+	}
+
+	price := roundAmount(gofakeit.Float64Range(0.1, 20))
+	return WarrantGrant{
+		Shares:               gofakeit.IntRange(100, 20000),
+		AcquisitionPrice:     price,
+		UnitValue:            roundAmount(price * gofakeit.Float64Range(1.5, 20)),
+		FrenchSourceFraction: 100,
+		AcquisitionDate:      acquisitionDate,
+		YearsInCompany:       int(acquisitionDate.Sub(truncateToDay(contract.ContractStartDate)).Hours() / 24 / 365.25),
+		NIR:                  individual.NIR,
+	}, true
+}
+
+// generateVestingChronology draws the date equity is acquired, during the year
+// of the declared month up to the end of the declared month, and the date it
+// was granted, after the start of the contract and at least vestingYears
+// before. ok is false when no such dates exist.
+func generateVestingChronology(contract Contrat, month time.Time, vestingYears int) (acquisitionDate, grantDate time.Time, ok bool) {
+	yearStart := time.Date(month.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	earliestGrant := truncateToDay(contract.ContractStartDate).AddDate(0, 0, 1)
+
+	earliestAcquisition := maxDate(yearStart, earliestGrant.AddDate(vestingYears, 0, 0))
+	if earliestAcquisition.After(lastDayOfMonth(month)) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	acquisitionDate = randomDay(earliestAcquisition, lastDayOfMonth(month))
+	latestGrant := acquisitionDate.AddDate(-vestingYears, 0, 0)
+
+	grantDate = randomDay(maxDate(earliestGrant, latestGrant.AddDate(-3, 0, 0)), latestGrant)
+	return acquisitionDate, grantDate, true
+}
+
+// DefinedBenefitPensionRight represents the rights acquired during the year by
+// an employee under a supplementary defined-benefit pension plan
+// French: Droit supplémentaire acquis au titre des régimes de retraite supplémentaire à prestations définies
+type DefinedBenefitPensionRight struct {
+	NIR                  string  `dsn:"S89.G00.67.001"`           // NIR
+	TemporaryTechnicalID string  `dsn:"S89.G00.67.002,omitempty"` // Numéro technique temporaire
+	Amount               float64 `dsn:"S89.G00.67.003,amount"`    // Montant de droit supplémentaire acquis
+	Percentage           float64 `dsn:"S89.G00.67.004,rate"`      // Pourcentage de droit supplémentaire acquis
+	Vintage              string  `dsn:"S89.G00.67.005"`           // Millésime de rattachement
+}
+
+// maxDefinedBenefitRate is the maximum yearly rate of rights that can be
+// acquired under a defined-benefit plan, in percent of the annual remuneration
+const maxDefinedBenefitRate = 3.0
+
+// GenerateDefinedBenefitPensionRight creates the DefinedBenefitPensionRight
+// acquired during the year of the declared month by an executive paid
+// monthlySalary. ok is false when the contract is not an executive one.
+func GenerateDefinedBenefitPensionRight(individual Individual, contract Contrat, month time.Time, monthlySalary float64) (right DefinedBenefitPensionRight, ok bool) {
+	if contract.MandatorySupplementaryPensionCode != PensionCategoryExecutive {
+		return DefinedBenefitPensionRight{}, false
+	}
+
+	percentage := roundRate(gofakeit.Float64Range(0.5, maxDefinedBenefitRate))
+	return DefinedBenefitPensionRight{
+		NIR:        individual.NIR,
+		Amount:     roundAmount(12 * monthlySalary * percentage / 100),
+		Percentage: percentage,
+		Vintage:    strconv.Itoa(month.Year()),
+	}, true
+}